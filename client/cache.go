@@ -0,0 +1,260 @@
+package client
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Cache stores raw response bodies of successful GET requests.
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get returns the value stored for key, if present and not expired.
+	Get(key string) ([]byte, bool)
+	// Set stores value for key. It should expire after ttl.
+	Set(key string, value []byte, ttl time.Duration)
+	// Delete removes key from the cache.
+	Delete(key string)
+}
+
+// CacheRule sets the TTL for every path matching Pattern.
+// Patterns use path.Match syntax, where * matches a single path segment,
+// e.g. "/movie/*", "/genre/*/list" or "/configuration".
+// A TTL of zero means matching responses are never cached.
+type CacheRule struct {
+	Pattern string
+	TTL     time.Duration
+}
+
+// CacheMode overrides the cache behavior for a single request.
+type CacheMode int
+
+const (
+	// CacheDefault reads from and writes to the cache according to the configured rules.
+	CacheDefault CacheMode = iota
+	// CacheBypass skips the cache entirely: the response is neither read from nor written to it.
+	CacheBypass
+	// CacheRefresh skips the cached value but stores the fresh response.
+	CacheRefresh
+)
+
+type cacheModeKey struct{}
+
+// WithCacheMode returns a copy of ctx that makes DoRequestContext use mode for the request.
+// Builders set this through their NoCache and Refresh methods.
+func WithCacheMode(ctx context.Context, mode CacheMode) context.Context {
+	if mode == CacheDefault {
+		return ctx
+	}
+	return context.WithValue(ctx, cacheModeKey{}, mode)
+}
+
+func cacheModeFrom(ctx context.Context) CacheMode {
+	mode, _ := ctx.Value(cacheModeKey{}).(CacheMode)
+	return mode
+}
+
+// buildCacheKey builds the key for a request from its method, path and query.
+// The api_key is left out so that keys don't leak it and don't change when it is rotated.
+func buildCacheKey(method, p string, query url.Values) string {
+	q := url.Values{}
+	for k, v := range query {
+		if k == "api_key" {
+			continue
+		}
+		q[k] = v
+	}
+	return method + " " + p + "?" + q.Encode()
+}
+
+// cacheTTL returns how long a response for p may be cached.
+// The first matching rule wins; unmatched paths use defaultTTL.
+func cacheTTL(rules []CacheRule, defaultTTL time.Duration, p string) time.Duration {
+	for _, rule := range rules {
+		if ok, _ := path.Match(rule.Pattern, p); ok {
+			return rule.TTL
+		}
+	}
+	return defaultTTL
+}
+
+// cacheable reports whether req may be served from or stored in the cache.
+// Only GET requests are cached, and never those tied to a user session.
+func cacheable(req *Request, mode CacheMode) bool {
+	if req.Method != http.MethodGet || mode == CacheBypass {
+		return false
+	}
+	return !req.Query.Has("session_id") && !req.Query.Has("guest_session_id")
+}
+
+// * IN-MEMORY LRU
+
+// LRUCache is an in-memory Cache that evicts the least recently used entries once full.
+type LRUCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	ll         *list.List
+	items      map[string]*list.Element
+}
+
+type lruEntry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+// NewLRUCache creates an in-memory cache holding at most maxEntries responses.
+// A maxEntries of zero or less means no limit.
+func NewLRUCache(maxEntries int) *LRUCache {
+	return &LRUCache{
+		maxEntries: maxEntries,
+		ll:         list.New(),
+		items:      make(map[string]*list.Element),
+	}
+}
+
+// Get implements Cache.
+func (c *LRUCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	entry := el.Value.(*lruEntry)
+	if time.Now().After(entry.expires) {
+		c.removeElement(el)
+		return nil, false
+	}
+	c.ll.MoveToFront(el)
+	return entry.value, true
+}
+
+// Set implements Cache.
+func (c *LRUCache) Set(key string, value []byte, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expires := time.Now().Add(ttl)
+	if el, ok := c.items[key]; ok {
+		entry := el.Value.(*lruEntry)
+		entry.value = value
+		entry.expires = expires
+		c.ll.MoveToFront(el)
+		return
+	}
+
+	c.items[key] = c.ll.PushFront(&lruEntry{key: key, value: value, expires: expires})
+	if c.maxEntries > 0 && c.ll.Len() > c.maxEntries {
+		c.removeElement(c.ll.Back())
+	}
+}
+
+// Delete implements Cache.
+func (c *LRUCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		c.removeElement(el)
+	}
+}
+
+// Len returns the number of entries currently in the cache, including expired ones not yet evicted.
+func (c *LRUCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ll.Len()
+}
+
+func (c *LRUCache) removeElement(el *list.Element) {
+	c.ll.Remove(el)
+	delete(c.items, el.Value.(*lruEntry).key)
+}
+
+// * FILE SYSTEM
+
+// FileCache is a Cache that stores one file per entry in a directory.
+// File names are hashes of the cache keys, so query parameters never appear on disk.
+type FileCache struct {
+	dir string
+}
+
+type fileEntry struct {
+	Expires time.Time       `json:"expires"`
+	Body    json.RawMessage `json:"body"`
+}
+
+// NewFileCache creates a file system cache in dir, creating the directory if needed.
+func NewFileCache(dir string) (*FileCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("tmdb: failed to create cache directory: %w", err)
+	}
+	return &FileCache{dir: dir}, nil
+}
+
+func (c *FileCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// Get implements Cache.
+func (c *FileCache) Get(key string) ([]byte, bool) {
+	data, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return nil, false
+	}
+	var entry fileEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, false
+	}
+	if time.Now().After(entry.Expires) {
+		c.Delete(key)
+		return nil, false
+	}
+	return entry.Body, true
+}
+
+// Set implements Cache. Write errors are ignored, the entry is simply not cached.
+func (c *FileCache) Set(key string, value []byte, ttl time.Duration) {
+	if !json.Valid(value) {
+		return
+	}
+	data, err := json.Marshal(fileEntry{Expires: time.Now().Add(ttl), Body: value})
+	if err != nil {
+		return
+	}
+
+	// Write to a temporary file first so readers never see a partial entry
+	tmp, err := os.CreateTemp(c.dir, "tmp-*")
+	if err != nil {
+		return
+	}
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return
+	}
+	if err := os.Rename(tmp.Name(), c.path(key)); err != nil {
+		os.Remove(tmp.Name())
+	}
+}
+
+// Delete implements Cache.
+func (c *FileCache) Delete(key string) {
+	os.Remove(c.path(key))
+}
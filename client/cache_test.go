@@ -0,0 +1,244 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLRUCacheEviction(t *testing.T) {
+	c := NewLRUCache(2)
+	c.Set("a", []byte("1"), time.Hour)
+	c.Set("b", []byte("2"), time.Hour)
+
+	// Reading a makes b the least recently used entry
+	if _, ok := c.Get("a"); !ok {
+		t.Fatal("a is missing")
+	}
+	c.Set("c", []byte("3"), time.Hour)
+
+	if _, ok := c.Get("b"); ok {
+		t.Error("b is still cached, want it evicted")
+	}
+	for _, key := range []string{"a", "c"} {
+		if _, ok := c.Get(key); !ok {
+			t.Errorf("%s is missing", key)
+		}
+	}
+	if n := c.Len(); n != 2 {
+		t.Errorf("Len = %d, want 2", n)
+	}
+}
+
+func TestLRUCacheExpiry(t *testing.T) {
+	c := NewLRUCache(0)
+	c.Set("a", []byte("1"), 20*time.Millisecond)
+	if v, ok := c.Get("a"); !ok || string(v) != "1" {
+		t.Fatalf("Get = %q, %t, want 1", v, ok)
+	}
+
+	time.Sleep(30 * time.Millisecond)
+	if _, ok := c.Get("a"); ok {
+		t.Error("a is still cached after its TTL")
+	}
+	if n := c.Len(); n != 0 {
+		t.Errorf("Len = %d, want the expired entry removed", n)
+	}
+}
+
+func TestFileCache(t *testing.T) {
+	dir := t.TempDir()
+	c, err := NewFileCache(dir)
+	if err != nil {
+		t.Fatalf("NewFileCache: %v", err)
+	}
+
+	key := "GET /search/movie?query=secret"
+	c.Set(key, []byte(`{"id":550}`), time.Hour)
+
+	// Entries survive across instances sharing the directory
+	c, err = NewFileCache(dir)
+	if err != nil {
+		t.Fatalf("NewFileCache: %v", err)
+	}
+	if v, ok := c.Get(key); !ok || string(v) != `{"id":550}` {
+		t.Errorf("Get = %q, %t, want the stored body", v, ok)
+	}
+
+	files, _ := os.ReadDir(dir)
+	if len(files) != 1 || strings.Contains(files[0].Name(), "secret") {
+		t.Errorf("files = %v, want one file named after the key's hash", files)
+	}
+
+	// Invalid bodies are not stored
+	c.Set("invalid", []byte("not json"), time.Hour)
+	if _, ok := c.Get("invalid"); ok {
+		t.Error("an invalid body was cached")
+	}
+
+	c.Set("expired", []byte(`{}`), -time.Second)
+	if _, ok := c.Get("expired"); ok {
+		t.Error("an expired entry was returned")
+	}
+	if _, err := os.Stat(c.path("expired")); !os.IsNotExist(err) {
+		t.Errorf("the expired entry's file is still there: %v", err)
+	}
+
+	c.Delete(key)
+	if _, ok := c.Get(key); ok {
+		t.Error("a deleted entry was returned")
+	}
+}
+
+func TestFileCacheCorruptFile(t *testing.T) {
+	c, err := NewFileCache(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileCache: %v", err)
+	}
+	if err := os.WriteFile(c.path("a"), []byte(`{"expires":`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := c.Get("a"); ok {
+		t.Error("a corrupt file was returned, want a miss")
+	}
+
+	// The next Set replaces it
+	c.Set("a", []byte(`{"id":1}`), time.Hour)
+	if v, ok := c.Get("a"); !ok || string(v) != `{"id":1}` {
+		t.Errorf("Get = %q, %t, want the new body", v, ok)
+	}
+	if matches, _ := filepath.Glob(filepath.Join(c.dir, "tmp-*")); len(matches) != 0 {
+		t.Errorf("temporary files left behind: %v", matches)
+	}
+}
+
+func TestBuildCacheKey(t *testing.T) {
+	key := buildCacheKey("GET", "/movie/550", url.Values{"api_key": {"one"}, "language": {"fr-FR"}, "append_to_response": {"credits"}})
+
+	if strings.Contains(key, "api_key") || strings.Contains(key, "one") {
+		t.Errorf("key %q contains the api_key", key)
+	}
+	if other := buildCacheKey("GET", "/movie/550", url.Values{"append_to_response": {"credits"}, "language": {"fr-FR"}, "api_key": {"two"}}); other != key {
+		t.Errorf("keys differ with another api_key: %q and %q", key, other)
+	}
+	if other := buildCacheKey("GET", "/movie/550", url.Values{"language": {"en-US"}, "append_to_response": {"credits"}}); other == key {
+		t.Errorf("requests with different languages share the key %q", key)
+	}
+}
+
+func TestCacheTTL(t *testing.T) {
+	rules := []CacheRule{
+		{Pattern: "/movie/550", TTL: 0},
+		{Pattern: "/movie/*", TTL: time.Hour},
+		{Pattern: "/genre/*/list", TTL: 24 * time.Hour},
+		{Pattern: "/movie/551", TTL: time.Second},
+	}
+
+	tests := []struct {
+		path string
+		want time.Duration
+	}{
+		{"/movie/550", 0},
+		{"/movie/551", time.Hour}, // matched by /movie/* first
+		{"/movie/551/credits", time.Minute},
+		{"/genre/tv/list", 24 * time.Hour},
+		{"/configuration", time.Minute},
+	}
+	for _, tt := range tests {
+		if got := cacheTTL(rules, time.Minute, tt.path); got != tt.want {
+			t.Errorf("cacheTTL(%s) = %s, want %s", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestCacheable(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		query  url.Values
+		mode   CacheMode
+		want   bool
+	}{
+		{"get", http.MethodGet, url.Values{"language": {"en-US"}}, CacheDefault, true},
+		{"refresh", http.MethodGet, nil, CacheRefresh, true},
+		{"bypass", http.MethodGet, nil, CacheBypass, false},
+		{"post", http.MethodPost, nil, CacheDefault, false},
+		{"delete", http.MethodDelete, nil, CacheDefault, false},
+		{"session", http.MethodGet, url.Values{"session_id": {"s"}}, CacheDefault, false},
+		{"guest session", http.MethodGet, url.Values{"guest_session_id": {"g"}}, CacheDefault, false},
+	}
+	for _, tt := range tests {
+		req := &Request{Method: tt.method, Path: "/movie/550/account_states", Query: tt.query}
+		if got := cacheable(req, tt.mode); got != tt.want {
+			t.Errorf("%s: cacheable = %t, want %t", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCacheMiddleware(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"n":%d}`, n)
+	}))
+	defer srv.Close()
+
+	cache := NewLRUCache(10)
+	c, err := New(Config{APIKey: "key", BaseURL: srv.URL, Cache: cache, CacheTTL: time.Hour})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	get := func(ctx context.Context, path string, query url.Values) int {
+		t.Helper()
+		var resp struct{ N int }
+		if err := c.DoRequestContext(ctx, http.MethodGet, path, query, nil, &resp); err != nil {
+			t.Fatalf("GET %s: %v", path, err)
+		}
+		return resp.N
+	}
+	ctx := context.Background()
+
+	if n := get(ctx, "/movie/550", nil); n != 1 {
+		t.Fatalf("first GET = %d, want 1", n)
+	}
+	if n := get(ctx, "/movie/550", nil); n != 1 {
+		t.Errorf("second GET = %d, want the cached 1", n)
+	}
+
+	// NoCache neither reads nor writes the cache
+	if n := get(WithCacheMode(ctx, CacheBypass), "/movie/550", nil); n != 2 {
+		t.Errorf("GET with CacheBypass = %d, want 2 from the server", n)
+	}
+	if n := get(ctx, "/movie/550", nil); n != 1 {
+		t.Errorf("GET after CacheBypass = %d, want the cached 1", n)
+	}
+
+	// Refresh skips the cached value and stores the fresh one
+	if n := get(WithCacheMode(ctx, CacheRefresh), "/movie/550", nil); n != 3 {
+		t.Errorf("GET with CacheRefresh = %d, want 3 from the server", n)
+	}
+	if n := get(ctx, "/movie/550", nil); n != 3 {
+		t.Errorf("GET after CacheRefresh = %d, want the refreshed 3", n)
+	}
+
+	// Session requests are never cached
+	session := url.Values{"session_id": {"s"}}
+	get(ctx, "/movie/550/account_states", session)
+	get(ctx, "/movie/550/account_states", session)
+	if n := requests.Load(); n != 5 {
+		t.Errorf("sent %d requests, want 5", n)
+	}
+	if n := cache.Len(); n != 1 {
+		t.Errorf("cache holds %d entries, want 1", n)
+	}
+}
@@ -33,6 +33,12 @@ type Client struct {
 
 	// baseURL is the parsed base URL for API requests.
 	baseURL *url.URL
+
+	// limiter throttles outgoing requests. nil when rate limiting is disabled.
+	limiter *RateLimiter
+
+	// handler is the transport wrapped in the configured middlewares.
+	handler Handler
 }
 
 type service struct {
@@ -60,6 +66,10 @@ func New(config Config) (*Client, error) {
 		return nil, errors.New("tmdb: BearerToken is required for v4 API base URL")
 	}
 
+	if config.UseProxy && config.HTTPClient != nil {
+		return nil, errors.New("tmdb: UseProxy can't be combined with a custom HTTPClient, set the proxy on its transport instead")
+	}
+
 	userAgent := config.UserAgent
 	httpClient := config.HTTPClient
 	if httpClient == nil {
@@ -72,6 +82,23 @@ func New(config Config) (*Client, error) {
 			userAgent = defaultUserAgent
 		}
 		httpClient = &http.Client{Timeout: timeout}
+
+		if config.UseProxy {
+			transport, err := newTransport(config)
+			if err != nil {
+				return nil, err
+			}
+			httpClient.Transport = transport
+		}
+	}
+
+	limiter := config.RateLimiter
+	if limiter == nil && config.RateLimit > 0 {
+		if config.ShareRateLimiter {
+			limiter = sharedRateLimiter(config.APIKey, config.RateLimit, config.RateBurst)
+		} else {
+			limiter = NewRateLimiter(config.RateLimit, config.RateBurst)
+		}
 	}
 
 	c := &Client{
@@ -79,7 +106,9 @@ func New(config Config) (*Client, error) {
 		userAgent:  userAgent,
 		httpClient: httpClient,
 		baseURL:    parsedBaseURL,
+		limiter:    limiter,
 	}
+	c.handler = Chain(c.transport, c.middlewares()...)
 
 	// TODO: validate API key on creation by making a test call
 
@@ -98,52 +127,129 @@ func New(config Config) (*Client, error) {
 // requestBody: Data to be sent as the request body for POST/PUT/DELETE (optional)
 // responseBody: Pointer to the struct where the successful response should be decoded (optional)
 func (c *Client) DoRequest(method, path string, queryParams url.Values, requestBody any, responseBody any) error {
-	// Construct the full URL
+	return c.DoRequestContext(context.Background(), method, path, queryParams, requestBody, responseBody)
+}
+
+// DoRequestContext is like DoRequest but carries ctx through to the underlying HTTP request,
+// so cancellation and deadlines set by the caller abort the call.
+// The same warning as DoRequest applies.
+func (c *Client) DoRequestContext(ctx context.Context, method, path string, queryParams url.Values, requestBody any, responseBody any) error {
+	if ctx == nil {
+		return errors.New("tmdb: nil context")
+	}
+
 	relURL, err := url.Parse(path)
 	if err != nil {
 		return fmt.Errorf("tmdb: invalid path %q: %w", path, err)
 	}
-	fullURL := fmt.Sprintf("%s%s", c.baseURL.String(), relURL.String())
 
-	// Prepares query parameters
-	if queryParams == nil {
-		queryParams = url.Values{}
+	// Prepares query parameters (copied, so the caller's values aren't modified down the chain)
+	query := url.Values{}
+	for k, v := range relURL.Query() {
+		query[k] = v
 	}
-
-	// Adds API key to query parameters if v3
-	if !strings.HasPrefix(c.baseURL.Path, "/4") {
-		queryParams.Set("api_key", c.config.APIKey)
+	for k, v := range queryParams {
+		query[k] = v
 	}
 
-	if len(queryParams) > 0 {
-		encodedParams := queryParams.Encode()
-		encodedParams = strings.ReplaceAll(encodedParams, "%2C", ",")
-		fullURL += "?" + encodedParams
-	}
-
-	fmt.Println(fullURL)
-
 	// Prepare request body (if any)
-	var bodyReader io.Reader
 	var reqBytes []byte
 	if requestBody != nil {
 		reqBytes, err = json.Marshal(requestBody)
 		if err != nil {
 			return fmt.Errorf("tmdb: failed to marshal request body: %w", err)
 		}
-		bodyReader = bytes.NewReader(reqBytes)
+	}
+
+	req := &Request{
+		Method: method,
+		Path:   relURL.Path,
+		Query:  query,
+		Header: http.Header{},
+		Body:   reqBytes,
+		Result: responseBody,
+	}
+	resp, err := c.handler(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	// Middlewares placed outside the decoding step (e.g. a cache in Config.Middlewares)
+	// may return a response that hasn't been decoded yet
+	return decodeInto(resp, responseBody)
+}
+
+// middlewares returns the middleware chain built from the config.
+// User middlewares come first so they see the final, decoded response.
+func (c *Client) middlewares() []Middleware {
+	mws := append([]Middleware{}, c.config.Middlewares...)
+	if c.config.Logger != nil {
+		mws = append(mws, LoggingMiddleware(c.config.Logger))
+	}
+	mws = append(mws, decodeMiddleware)
+	if c.config.CoalesceRequests {
+		mws = append(mws, CoalesceMiddleware())
+	}
+	if c.config.Cache != nil {
+		mws = append(mws, CacheMiddleware(c.config.Cache, c.config.CacheRules, c.config.CacheTTL))
+	}
+	if c.config.Retry != nil {
+		mws = append(mws, RetryMiddleware(c.config.Retry))
+	}
+	if c.limiter != nil {
+		mws = append(mws, RateLimitMiddleware(c.limiter))
+	}
+	return mws
+}
+
+// decodeResponse decodes a successful response body into responseBody (if expected).
+func decodeResponse(respBytes []byte, responseBody any) error {
+	if responseBody != nil {
+		if err := json.Unmarshal(respBytes, responseBody); err != nil {
+			return fmt.Errorf("tmdb: failed to decode response body into %T: %w\nBody: %s", responseBody, err, string(respBytes))
+		}
+	}
+	return nil
+}
+
+// transport is the innermost Handler: it performs a single HTTP round trip.
+// It adds the api_key and the default headers, so middlewares never see them.
+func (c *Client) transport(ctx context.Context, r *Request) (*Response, error) {
+	// Construct the full URL
+	fullURL := c.baseURL.String() + r.Path
+
+	query := url.Values{}
+	for k, v := range r.Query {
+		query[k] = v
+	}
+	// Adds API key to query parameters if v3
+	if !strings.HasPrefix(c.baseURL.Path, "/4") {
+		query.Set("api_key", c.config.APIKey)
+	}
+	if len(query) > 0 {
+		encodedParams := query.Encode()
+		encodedParams = strings.ReplaceAll(encodedParams, "%2C", ",")
+		fullURL += "?" + encodedParams
+	}
+
+	var bodyReader io.Reader
+	if r.Body != nil {
+		bodyReader = bytes.NewReader(r.Body)
 	}
 
 	// Create the HTTP request
-	req, err := http.NewRequest(method, fullURL, bodyReader)
+	req, err := http.NewRequestWithContext(ctx, r.Method, fullURL, bodyReader)
 	if err != nil {
-		return fmt.Errorf("tmdb: failed to create request: %w", err)
+		return nil, fmt.Errorf("tmdb: failed to create request: %w", redactError(err))
 	}
 
 	// Set headers
+	for k, v := range r.Header {
+		req.Header[k] = v
+	}
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", c.userAgent)
-	if requestBody != nil {
+	if r.Body != nil {
 		req.Header.Set("Content-Type", "application/json")
 	}
 	// Adds Bearer token if configured
@@ -151,43 +257,39 @@ func (c *Client) DoRequest(method, path string, queryParams url.Values, requestB
 		req.Header.Set("Authorization", "Bearer "+c.config.BearerToken)
 	}
 
-	// Execute the request
+	if r.Attempt == 0 {
+		r.Attempt = 1
+	}
+
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		// Handles timeout and other context errors
 		if errors.Is(err, context.DeadlineExceeded) {
-			return fmt.Errorf("tmdb: request timed out: %w", err)
+			return nil, fmt.Errorf("tmdb: request timed out: %w", redactError(err))
+		}
+		if errors.Is(err, context.Canceled) {
+			return nil, fmt.Errorf("tmdb: request canceled: %w", redactError(err))
 		}
-		return fmt.Errorf("tmdb: request failed: %w", err)
+		return nil, fmt.Errorf("tmdb: request failed: %w", redactError(err))
 	}
 	defer resp.Body.Close()
 
 	// Reading the response body
 	respBytes, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Errorf("tmdb: failed to read response body: %w", err)
+		return nil, fmt.Errorf("tmdb: failed to read response body: %w", err)
 	}
 
-	// Checking for API errors (non-2xx status codes)
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		apiErr := &TMDBError{}
-		// Attempting to decode the TMDB error structure
-		if decodeErr := json.Unmarshal(respBytes, apiErr); decodeErr == nil && apiErr.StatusMessage != "" {
-			// Using the decoded TMDB error if successful
-			apiErr.StatusCode = resp.StatusCode
-			return apiErr
-		} else {
-			// Fallback if decoding fails or message is empty
-			return NewTMDBError(resp.StatusCode, fmt.Sprintf("unexpected status code %d with body: %s", resp.StatusCode, string(respBytes)))
-		}
+	out := &Response{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       respBytes,
 	}
 
-	// Decoding successful response body (if expected)
-	if responseBody != nil {
-		if err := json.Unmarshal(respBytes, responseBody); err != nil {
-			return fmt.Errorf("tmdb: failed to decode response body into %T: %w\nBody: %s", responseBody, err, string(respBytes))
-		}
+	// Checking for API errors (non-2xx status codes)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return out, newAPIError(r, resp, respBytes)
 	}
 
-	return nil
+	return out, nil
 }
@@ -0,0 +1,103 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// coalescer shares one upstream request between identical concurrent GETs.
+type coalescer struct {
+	mu    sync.Mutex
+	calls map[string]*inflight
+}
+
+// inflight is a request shared by one or more callers.
+type inflight struct {
+	done    chan struct{}
+	req     *Request
+	resp    *Response
+	err     error
+	waiters int
+	cancel  context.CancelFunc
+}
+
+// CoalesceMiddleware makes concurrent GET requests with the same path and query share a single
+// upstream request and its result. The shared request isn't tied to any single caller:
+// a caller giving up only stops waiting, and the request is canceled once every caller gave up.
+// Only the HTTP client's timeout applies to the shared request, not the callers' deadlines.
+func CoalesceMiddleware() Middleware {
+	co := &coalescer{calls: make(map[string]*inflight)}
+	return func(next Handler) Handler {
+		return func(ctx context.Context, req *Request) (*Response, error) {
+			if req.Method != http.MethodGet {
+				return next(ctx, req)
+			}
+			return co.do(ctx, next, req)
+		}
+	}
+}
+
+func (co *coalescer) do(ctx context.Context, next Handler, req *Request) (*Response, error) {
+	// The cache mode is part of the key so that a Refresh isn't answered by a call that may hit the cache
+	key := fmt.Sprintf("%d %s", cacheModeFrom(ctx), buildCacheKey(req.Method, req.Path, req.Query))
+
+	co.mu.Lock()
+	call, shared := co.calls[key]
+	if shared {
+		call.waiters++
+	} else {
+		// The shared call gets its own copy of the request, as the caller may return before it's done
+		sharedReq := *req
+		sharedReq.Header = req.Header.Clone()
+		callCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		call = &inflight{done: make(chan struct{}), req: &sharedReq, waiters: 1, cancel: cancel}
+		co.calls[key] = call
+		go co.run(callCtx, key, call, next)
+	}
+	co.mu.Unlock()
+
+	select {
+	case <-call.done:
+		req.Attempt = call.req.Attempt
+		// Every caller gets its own copy of the response and of an API error,
+		// since outer middlewares may modify them (e.g. when decoding or counting attempts)
+		err := call.err
+		if apiErr, ok := err.(*TMDBError); ok {
+			errCopy := *apiErr
+			err = &errCopy
+		}
+		if call.resp == nil {
+			return nil, err
+		}
+		resp := *call.resp
+		resp.Value = nil
+		resp.Coalesced = shared
+		return &resp, err
+	case <-ctx.Done():
+		co.mu.Lock()
+		call.waiters--
+		if call.waiters == 0 {
+			call.cancel()
+			if co.calls[key] == call {
+				delete(co.calls, key)
+			}
+		}
+		co.mu.Unlock()
+		return nil, fmt.Errorf("tmdb: request canceled: %w", ctx.Err())
+	}
+}
+
+func (co *coalescer) run(ctx context.Context, key string, call *inflight, next Handler) {
+	defer call.cancel()
+
+	call.resp, call.err = next(ctx, call.req)
+
+	co.mu.Lock()
+	if co.calls[key] == call {
+		delete(co.calls, key)
+	}
+	co.mu.Unlock()
+	close(call.done)
+}
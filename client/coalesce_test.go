@@ -0,0 +1,156 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/falconer001/gotmdb/client"
+	"github.com/falconer001/gotmdb/tmdbtest"
+)
+
+func coalescing(config *client.Config) {
+	config.CoalesceRequests = true
+}
+
+// concurrently runs n calls of f at once and waits for them.
+func concurrently(n int, f func(i int)) {
+	var wg sync.WaitGroup
+	for i := range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			f(i)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestCoalesce(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	srv.SetLatency("GET /movie/{id}", 50*time.Millisecond)
+	c := newClient(t, srv, coalescing)
+
+	concurrently(10, func(int) {
+		var movie struct{ ID int }
+		if err := c.DoRequestContext(context.Background(), http.MethodGet, "/movie/550", nil, nil, &movie); err != nil {
+			t.Errorf("GET /movie/550: %v", err)
+		}
+		if movie.ID != 550 {
+			t.Errorf("ID = %d, want 550", movie.ID)
+		}
+	})
+	if n := len(srv.Requests()); n != 1 {
+		t.Errorf("sent %d requests, want 1", n)
+	}
+}
+
+func TestCoalesceError(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	srv.SetLatency("GET /movie/{id}", 50*time.Millisecond)
+	srv.Fail("GET /movie/{id}", http.StatusNotFound, 34, "The resource you requested could not be found.")
+	c := newClient(t, srv, coalescing)
+
+	errs := make([]*client.TMDBError, 5)
+	concurrently(len(errs), func(i int) {
+		err := c.DoRequestContext(context.Background(), http.MethodGet, "/movie/550", nil, nil, nil)
+		if !errors.As(err, &errs[i]) || !errors.Is(err, client.ErrResourceNotFound) {
+			t.Errorf("err = %v, want a *TMDBError matching ErrResourceNotFound", err)
+		}
+	})
+	if t.Failed() {
+		return
+	}
+
+	// Each caller got its own copy of the error
+	errs[0].Attempts = 42
+	for _, err := range errs[1:] {
+		if err == errs[0] || err.Attempts == 42 {
+			t.Fatal("callers share the same *TMDBError")
+		}
+	}
+	if n := len(srv.Requests()); n != 1 {
+		t.Errorf("sent %d requests, want 1", n)
+	}
+}
+
+func TestCoalesceWaiterGivesUp(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	srv.SetLatency("GET /movie/{id}", 100*time.Millisecond)
+	c := newClient(t, srv, coalescing)
+
+	// The first caller starts the request then gives up, the second still gets the response
+	concurrently(2, func(i int) {
+		ctx := context.Background()
+		if i == 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, 20*time.Millisecond)
+			defer cancel()
+		} else {
+			time.Sleep(5 * time.Millisecond)
+		}
+
+		err := c.DoRequestContext(ctx, http.MethodGet, "/movie/550", nil, nil, nil)
+		switch {
+		case i == 0 && !errors.Is(err, context.DeadlineExceeded):
+			t.Errorf("the caller giving up got %v, want context.DeadlineExceeded", err)
+		case i == 1 && err != nil:
+			t.Errorf("the caller still waiting got %v", err)
+		}
+	})
+	if n := len(srv.Requests()); n != 1 {
+		t.Errorf("sent %d requests, want 1", n)
+	}
+}
+
+func TestCoalesceEveryWaiterGivesUp(t *testing.T) {
+	canceled := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+			close(canceled)
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	defer srv.Close()
+	c := newLimitedClient(t, client.Config{APIKey: "key", BaseURL: srv.URL, CoalesceRequests: true})
+
+	concurrently(3, func(int) {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+		defer cancel()
+		if err := c.DoRequestContext(ctx, http.MethodGet, "/movie/550", nil, nil, nil); !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("err = %v, want context.DeadlineExceeded", err)
+		}
+	})
+
+	select {
+	case <-canceled:
+	case <-time.After(time.Second):
+		t.Error("the shared request wasn't canceled once every caller gave up")
+	}
+}
+
+func TestCoalesceSkipsPOST(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	srv.Respond("POST /movie/{id}/rating", http.StatusCreated, map[string]any{"status_code": 1, "status_message": "Success."})
+	srv.SetLatency("POST /movie/{id}/rating", 50*time.Millisecond)
+	c := newClient(t, srv, coalescing)
+
+	concurrently(3, func(int) {
+		body := map[string]float64{"value": 8.5}
+		if err := c.DoRequestContext(context.Background(), http.MethodPost, "/movie/550/rating", nil, body, nil); err != nil {
+			t.Errorf("POST /movie/550/rating: %v", err)
+		}
+	})
+	if n := len(srv.Requests()); n != 3 {
+		t.Errorf("sent %d requests, want 3", n)
+	}
+}
@@ -1,33 +1,132 @@
 package client
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"slices"
+	"time"
 )
 
+// Sentinel errors matched by TMDBError through errors.Is, e.g.
+//
+//	if errors.Is(err, client.ErrNotFound) { ... }
+//
+// They are derived from TMDb's own status_code (see https://developer.themoviedb.org/docs/errors)
+// and, for the more general ones, from the HTTP status code.
+var (
+	// ErrNotFound matches any 404 response.
+	ErrNotFound = errors.New("tmdb: not found")
+	// ErrResourceNotFound matches "The resource you requested could not be found" (34) and "Invalid id" (6).
+	ErrResourceNotFound = errors.New("tmdb: resource not found")
+	// ErrInvalidAPIKey matches invalid (7) and suspended (10) API keys.
+	ErrInvalidAPIKey = errors.New("tmdb: invalid API key")
+	// ErrAuthenticationFailed matches failed authentication and missing permissions (3, 14, 30, 35, 36, 38, 39).
+	ErrAuthenticationFailed = errors.New("tmdb: authentication failed")
+	// ErrSessionDenied matches denied (17) and unknown (37) sessions.
+	ErrSessionDenied = errors.New("tmdb: session denied")
+	// ErrInvalidRequestToken matches invalid (33) and unapproved (41) request tokens.
+	ErrInvalidRequestToken = errors.New("tmdb: invalid request token")
+	// ErrRateLimited matches 429 responses and "Your request count is over the allowed limit" (25).
+	ErrRateLimited = errors.New("tmdb: rate limited")
+	// ErrInvalidParameters matches invalid parameters, pages, dates, timezones and the like
+	// (5, 18, 20, 22, 23, 27, 28, 47).
+	ErrInvalidParameters = errors.New("tmdb: invalid parameters")
+	// ErrServiceUnavailable matches TMDb outages, maintenance and backend errors (9, 11, 15, 24, 43, 46).
+	ErrServiceUnavailable = errors.New("tmdb: service unavailable")
+)
+
+// tmdbCodes maps TMDb status codes to the sentinel they match.
+var tmdbCodes = map[int]error{
+	3:  ErrAuthenticationFailed,
+	5:  ErrInvalidParameters,
+	6:  ErrResourceNotFound,
+	7:  ErrInvalidAPIKey,
+	9:  ErrServiceUnavailable,
+	10: ErrInvalidAPIKey,
+	11: ErrServiceUnavailable,
+	14: ErrAuthenticationFailed,
+	15: ErrServiceUnavailable,
+	17: ErrSessionDenied,
+	18: ErrInvalidParameters,
+	20: ErrInvalidParameters,
+	22: ErrInvalidParameters,
+	23: ErrInvalidParameters,
+	24: ErrServiceUnavailable,
+	25: ErrRateLimited,
+	27: ErrInvalidParameters,
+	28: ErrInvalidParameters,
+	30: ErrAuthenticationFailed,
+	33: ErrInvalidRequestToken,
+	34: ErrResourceNotFound,
+	35: ErrAuthenticationFailed,
+	36: ErrAuthenticationFailed,
+	37: ErrSessionDenied,
+	38: ErrAuthenticationFailed,
+	39: ErrAuthenticationFailed,
+	41: ErrInvalidRequestToken,
+	43: ErrServiceUnavailable,
+	46: ErrServiceUnavailable,
+	47: ErrInvalidParameters,
+}
+
+// retryableCodes are TMDb status codes for failures that may succeed when retried.
+var retryableCodes = []int{9, 11, 24, 25, 43, 46}
+
 // TMDBError represents an error response from the TMDB API.
 // It includes the status code and message provided by the API.
 // See: https://developer.themoviedb.org/docs/errors
 type TMDBError struct {
-	StatusCode    int    `json:"status_code"`    // The HTTP status code.
+	StatusCode    int    `json:"-"`              // The HTTP status code.
+	Code          int    `json:"status_code"`    // TMDB's own status code, e.g. 34 for "resource not found". 0 if the body had none.
 	StatusMessage string `json:"status_message"` // The error message from TMDB.
+	Attempts      int    `json:"-"`              // How many times the request was sent (more than 1 when retried).
 	// Success field is sometimes present in error responses, often false.
 	// I primarily rely on HTTP status code for error detection.
 	// Success       *bool  `json:"success,omitempty"`
+
+	// Path is the request path and query, with the api_key and session IDs redacted.
+	Path string `json:"-"`
+	// Body is the raw response body.
+	Body []byte `json:"-"`
+	// RetryAfter is the wait requested by TMDb through the Retry-After header, 0 if absent.
+	RetryAfter time.Duration `json:"-"`
+	// Retryable reports whether the same request may succeed if sent again later
+	// (rate limiting, server errors, TMDb outages).
+	Retryable bool `json:"-"`
 }
 
 // Error returns the string representation of the TMDBError.
 func (e *TMDBError) Error() string {
+	if e.Attempts > 1 {
+		return fmt.Sprintf("tmdb: API error (HTTP %d) after %d attempts: %s", e.StatusCode, e.Attempts, e.StatusMessage)
+	}
 	return fmt.Sprintf("tmdb: API error (HTTP %d): %s", e.StatusCode, e.StatusMessage)
 }
 
-// Is checks if the target error is a TMDBError with the same status code.
+// Is checks if the target error is a TMDBError with the same status code,
+// or one of the sentinel errors (ErrNotFound, ErrRateLimited, ...) matching this error.
 // This helps with error checking using errors.Is.
 func (e *TMDBError) Is(target error) bool {
-	tmdbErr, ok := target.(*TMDBError)
-	if !ok {
-		return false
+	if tmdbErr, ok := target.(*TMDBError); ok {
+		return e.StatusCode == tmdbErr.StatusCode
+	}
+
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrRateLimited:
+		if e.StatusCode == http.StatusTooManyRequests {
+			return true
+		}
+	case ErrServiceUnavailable:
+		if e.StatusCode == http.StatusServiceUnavailable {
+			return true
+		}
 	}
-	return e.StatusCode == tmdbErr.StatusCode
+	sentinel, ok := tmdbCodes[e.Code]
+	return ok && sentinel == target
 }
 
 // NewTMDBError creates a new TMDBError.
@@ -35,5 +134,38 @@ func NewTMDBError(code int, message string) *TMDBError {
 	return &TMDBError{
 		StatusCode:    code,
 		StatusMessage: message,
+		Retryable:     isRetryableStatus(code, 0),
+	}
+}
+
+// newAPIError builds the error for a non-2xx response to req.
+func newAPIError(req *Request, resp *http.Response, body []byte) *TMDBError {
+	apiErr := &TMDBError{}
+	// Attempting to decode the TMDB error structure
+	if decodeErr := json.Unmarshal(body, apiErr); decodeErr != nil || apiErr.StatusMessage == "" {
+		// Fallback if decoding fails or message is empty
+		apiErr = &TMDBError{
+			StatusMessage: fmt.Sprintf("unexpected status code %d with body: %s", resp.StatusCode, string(body)),
+		}
+	}
+
+	apiErr.StatusCode = resp.StatusCode
+	apiErr.Attempts = req.Attempt
+	apiErr.Body = body
+	apiErr.Path = req.Path
+	if len(req.Query) > 0 {
+		apiErr.Path += "?" + redactQuery(req.Query)
+	}
+	apiErr.RetryAfter, _ = parseRetryAfter(resp.Header.Get("Retry-After"))
+	apiErr.Retryable = isRetryableStatus(resp.StatusCode, apiErr.Code)
+	return apiErr
+}
+
+func isRetryableStatus(httpStatus, tmdbCode int) bool {
+	switch httpStatus {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
 	}
+	return slices.Contains(retryableCodes, tmdbCode)
 }
@@ -0,0 +1,145 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// sentinels lists every sentinel error, to check that an error matches none but the expected ones.
+var sentinels = []error{
+	ErrNotFound, ErrResourceNotFound, ErrInvalidAPIKey, ErrAuthenticationFailed, ErrSessionDenied,
+	ErrInvalidRequestToken, ErrRateLimited, ErrInvalidParameters, ErrServiceUnavailable,
+}
+
+func TestTMDBCodes(t *testing.T) {
+	for code, sentinel := range tmdbCodes {
+		err := &TMDBError{StatusCode: http.StatusBadRequest, Code: code}
+		for _, target := range sentinels {
+			if got, want := errors.Is(err, target), target == sentinel; got != want {
+				t.Errorf("code %d: errors.Is(%v) = %t, want %t", code, target, got, want)
+			}
+		}
+	}
+}
+
+func TestTMDBError(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     int
+		body       string
+		retryAfter string
+		attempts   int
+		want       []error
+		retryable  bool
+		wait       time.Duration
+	}{
+		{
+			name:   "invalid API key",
+			status: http.StatusUnauthorized,
+			body:   `{"status_code":7,"status_message":"Invalid API key: You must be granted a valid key."}`,
+			want:   []error{ErrInvalidAPIKey},
+		},
+		{
+			name:   "resource not found",
+			status: http.StatusNotFound,
+			body:   `{"status_code":34,"status_message":"The resource you requested could not be found."}`,
+			want:   []error{ErrNotFound, ErrResourceNotFound},
+		},
+		{
+			name:       "rate limited",
+			status:     http.StatusTooManyRequests,
+			body:       `{"status_code":25,"status_message":"Your request count (41) is over the allowed limit of 40."}`,
+			retryAfter: "2",
+			want:       []error{ErrRateLimited},
+			retryable:  true,
+			wait:       2 * time.Second,
+		},
+		{
+			name:      "rate limited after retries",
+			status:    http.StatusTooManyRequests,
+			body:      `{"status_code":25,"status_message":"Your request count (41) is over the allowed limit of 40."}`,
+			attempts:  3,
+			want:      []error{ErrRateLimited},
+			retryable: true,
+		},
+		{
+			name:   "session denied",
+			status: http.StatusUnauthorized,
+			body:   `{"status_code":17,"status_message":"Session denied."}`,
+			want:   []error{ErrSessionDenied},
+		},
+		{
+			name:   "invalid page",
+			status: http.StatusBadRequest,
+			body:   `{"status_code":22,"status_message":"Invalid page: Pages start at 1 and max at 500."}`,
+			want:   []error{ErrInvalidParameters},
+		},
+		{
+			name:      "service offline",
+			status:    http.StatusServiceUnavailable,
+			body:      `{"status_code":9,"status_message":"Service offline: This service is temporarily offline, try again later."}`,
+			want:      []error{ErrServiceUnavailable},
+			retryable: true,
+		},
+		{
+			name:      "gateway error without a TMDb body",
+			status:    http.StatusBadGateway,
+			body:      `<html>Bad Gateway</html>`,
+			retryable: true,
+		},
+	}
+
+	for _, tt := range tests {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if tt.retryAfter != "" {
+				w.Header().Set("Retry-After", tt.retryAfter)
+			}
+			w.WriteHeader(tt.status)
+			_, _ = w.Write([]byte(tt.body))
+		}))
+		config := Config{APIKey: "key", BaseURL: srv.URL}
+		if tt.attempts > 1 {
+			config.Retry = &RetryPolicy{MaxAttempts: tt.attempts, InitialBackoff: time.Millisecond}
+		}
+		c, err := New(config)
+		if err != nil {
+			t.Fatalf("New: %v", err)
+		}
+		err = c.DoRequestContext(context.Background(), http.MethodGet, "/movie/550", url.Values{"session_id": {"secret"}}, nil, nil)
+		srv.Close()
+
+		var apiErr *TMDBError
+		if !errors.As(err, &apiErr) {
+			t.Errorf("%s: err = %v, want a *TMDBError", tt.name, err)
+			continue
+		}
+		for _, target := range sentinels {
+			if got, want := errors.Is(err, target), errors.Is(errors.Join(tt.want...), target); got != want {
+				t.Errorf("%s: errors.Is(%v) = %t, want %t", tt.name, target, got, want)
+			}
+		}
+		if !errors.Is(err, NewTMDBError(tt.status, "")) {
+			t.Errorf("%s: doesn't match a TMDBError with the same HTTP status", tt.name)
+		}
+
+		attempts := max(tt.attempts, 1)
+		if apiErr.StatusCode != tt.status || apiErr.Attempts != attempts || apiErr.Retryable != tt.retryable || apiErr.RetryAfter != tt.wait {
+			t.Errorf("%s: got status %d, %d attempts, retryable %t, retry after %s, want %d, %d, %t, %s",
+				tt.name, apiErr.StatusCode, apiErr.Attempts, apiErr.Retryable, apiErr.RetryAfter,
+				tt.status, attempts, tt.retryable, tt.wait)
+		}
+		if string(apiErr.Body) != tt.body || strings.Contains(apiErr.Path, "secret") {
+			t.Errorf("%s: Body = %s, Path = %s, want the raw body and a redacted path", tt.name, apiErr.Body, apiErr.Path)
+		}
+		if attempts > 1 && !strings.Contains(err.Error(), fmt.Sprintf("after %d attempts", attempts)) {
+			t.Errorf("%s: message %q doesn't mention the attempts", tt.name, err)
+		}
+	}
+}
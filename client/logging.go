@@ -0,0 +1,134 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const redacted = "REDACTED"
+
+// sensitiveParams are query parameters that are never written to logs or errors.
+var sensitiveParams = []string{"api_key", "session_id", "guest_session_id", "request_token"}
+
+var discardLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// Logger returns the logger configured in Config.Logger,
+// or a logger that discards everything when none was set.
+func (c *Client) Logger() *slog.Logger {
+	if c.config.Logger == nil {
+		return discardLogger
+	}
+	return c.config.Logger
+}
+
+// redactQuery encodes query with the values of sensitive parameters replaced.
+func redactQuery(query url.Values) string {
+	if len(query) == 0 {
+		return ""
+	}
+	clean := make(url.Values, len(query))
+	for k, v := range query {
+		clean[k] = v
+	}
+	for _, k := range sensitiveParams {
+		if clean.Has(k) {
+			clean.Set(k, redacted)
+		}
+	}
+	return clean.Encode()
+}
+
+// redactURL returns rawURL with the values of sensitive query parameters replaced.
+// If rawURL can't be parsed, its whole query is dropped.
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		if i := strings.IndexByte(rawURL, '?'); i >= 0 {
+			return rawURL[:i]
+		}
+		return rawURL
+	}
+	u.RawQuery = redactQuery(u.Query())
+	return u.String()
+}
+
+// redactError scrubs the request URL carried by *url.Error values,
+// which net/http includes in every transport error message.
+func redactError(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		urlErr.URL = redactURL(urlErr.URL)
+	}
+	return err
+}
+
+type loggerKey struct{}
+
+func withLogger(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, logger)
+}
+
+// loggerFrom returns the logger set by LoggingMiddleware, or a discarding one.
+func loggerFrom(ctx context.Context) *slog.Logger {
+	if logger, ok := ctx.Value(loggerKey{}).(*slog.Logger); ok {
+		return logger
+	}
+	return discardLogger
+}
+
+// logRequest emits the event for a finished call.
+func logRequest(ctx context.Context, logger *slog.Logger, req *Request, resp *Response, start time.Time, err error) {
+	level := slog.LevelDebug
+	if err != nil {
+		level = slog.LevelWarn
+	}
+	if !logger.Enabled(ctx, level) {
+		return
+	}
+
+	var status, size int
+	var cached bool
+	if resp != nil {
+		status, size, cached = resp.StatusCode, len(resp.Body), resp.CacheHit
+	}
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("path", req.Path),
+		slog.String("query", redactQuery(req.Query)),
+		slog.Int("status", status),
+		slog.Duration("duration", time.Since(start)),
+		slog.Int("bytes", size),
+		slog.Int("retries", max(req.Attempt-1, 0)),
+		slog.Bool("cache_hit", cached),
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+		logger.LogAttrs(ctx, level, "tmdb: request failed", attrs...)
+		return
+	}
+	logger.LogAttrs(ctx, level, "tmdb: request completed", attrs...)
+}
+
+// logRetry emits the event for an attempt that is about to be retried.
+func logRetry(ctx context.Context, req *Request, resp *Response, wait time.Duration, err error) {
+	var status int
+	if resp != nil {
+		status = resp.StatusCode
+	}
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("path", req.Path),
+		slog.Int("attempt", req.Attempt),
+		slog.Int("status", status),
+		slog.Duration("wait", wait),
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+	loggerFrom(ctx).LogAttrs(ctx, slog.LevelInfo, "tmdb: retrying request", attrs...)
+}
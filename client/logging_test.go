@@ -0,0 +1,149 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// secrets are the values that must never reach the logs.
+var secrets = []string{"secret-api-key", "secret-bearer-token", "secret-session", "secret-guest-session", "secret-request-token"}
+
+// syncBuffer is a bytes.Buffer safe for the concurrent writes of the logger.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestLoggingRedaction(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		// The first attempt fails and is retried, so the retry event is logged too
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"status_code":9,"status_message":"Service offline."}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	var logs syncBuffer
+	config := Config{
+		APIKey:      "secret-api-key",
+		BearerToken: "secret-bearer-token",
+		BaseURL:     srv.URL,
+		Logger:      slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug})),
+		Retry:       &RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond},
+	}
+	c, err := New(config)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	query := url.Values{
+		"session_id":       {"secret-session"},
+		"guest_session_id": {"secret-guest-session"},
+		"request_token":    {"secret-request-token"},
+		"language":         {"en-US"},
+	}
+	ctx := context.Background()
+	if err := c.DoRequestContext(ctx, http.MethodGet, "/movie/550/account_states", query, nil, nil); err != nil {
+		t.Fatalf("GET: %v", err)
+	}
+
+	// A transport error carries the full URL in its message
+	srv.Close()
+	err = c.DoRequestContext(ctx, http.MethodGet, "/movie/550/account_states", query, nil, nil)
+	if err == nil {
+		t.Fatal("GET on a closed server succeeded")
+	}
+
+	out := logs.String()
+	for _, want := range []string{"tmdb: retrying request", "tmdb: request completed", "tmdb: request failed", "language=en-US", redacted} {
+		if !strings.Contains(out, want) {
+			t.Errorf("logs miss %q:\n%s", want, out)
+		}
+	}
+	for _, secret := range secrets {
+		if strings.Contains(out, secret) {
+			t.Errorf("logs contain %q:\n%s", secret, out)
+		}
+		if strings.Contains(err.Error(), secret) {
+			t.Errorf("error contains %q: %v", secret, err)
+		}
+	}
+}
+
+func TestRedactError(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		want    string
+		notWant []string
+	}{
+		{
+			name:    "query",
+			url:     "https://api.themoviedb.org/3/account/1?api_key=secret-api-key&session_id=secret-session&language=en-US",
+			want:    "https://api.themoviedb.org/3/account/1?api_key=REDACTED&language=en-US&session_id=REDACTED",
+			notWant: secrets,
+		},
+		{
+			name: "no query",
+			url:  "https://api.themoviedb.org/3/movie/550",
+			want: "https://api.themoviedb.org/3/movie/550",
+		},
+		{
+			name:    "invalid URL",
+			url:     "https://[api.themoviedb.org/3/movie/550?api_key=secret-api-key",
+			want:    "https://[api.themoviedb.org/3/movie/550",
+			notWant: secrets,
+		},
+	}
+	for _, tt := range tests {
+		// errors.Join builds its message lazily, so it shows the scrubbed URL of the wrapped error
+		urlErr := &url.Error{Op: "Get", URL: tt.url, Err: io.ErrUnexpectedEOF}
+		err := redactError(errors.Join(errors.New("tmdb: request failed"), urlErr))
+
+		if urlErr.URL != tt.want {
+			t.Errorf("%s: URL = %q, want %q", tt.name, urlErr.URL, tt.want)
+		}
+		for _, secret := range tt.notWant {
+			if strings.Contains(err.Error(), secret) {
+				t.Errorf("%s: error contains %q: %v", tt.name, secret, err)
+			}
+		}
+		if !errors.Is(err, io.ErrUnexpectedEOF) {
+			t.Errorf("%s: the cause was lost: %v", tt.name, err)
+		}
+	}
+
+	// Other errors are returned as is
+	plain := errors.New("tmdb: boom")
+	if err := redactError(plain); err != plain {
+		t.Errorf("redactError changed %v into %v", plain, err)
+	}
+}
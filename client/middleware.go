@@ -0,0 +1,205 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Request is an outgoing API call as seen by middlewares.
+// Middlewares may modify it before passing it on, e.g. to add headers.
+type Request struct {
+	// Method is the HTTP method, e.g. "GET".
+	Method string
+
+	// Path is the API path relative to the base URL, e.g. "/movie/550".
+	Path string
+
+	// Query holds the query parameters. The api_key is added by the transport
+	// and never appears here.
+	Query url.Values
+
+	// Header holds extra headers sent with the request.
+	// Accept, User-Agent, Content-Type and Authorization are set by the transport.
+	Header http.Header
+
+	// Body is the JSON encoded request body, nil if there is none.
+	Body []byte
+
+	// Result is the value the response body will be decoded into, nil if not expected.
+	Result any
+
+	// Attempt is the number of times the request has been sent so far.
+	// It's greater than 1 only when the retry middleware resent it.
+	Attempt int
+}
+
+// Response is the result of an API call as seen by middlewares.
+type Response struct {
+	// StatusCode is the HTTP status code.
+	StatusCode int
+
+	// Header holds the response headers. It's nil for cached responses.
+	Header http.Header
+
+	// Body is the raw response body.
+	Body []byte
+
+	// Value is the decoded response (the request's Result) once it has been decoded.
+	Value any
+
+	// CacheHit reports whether the response was served from the cache.
+	CacheHit bool
+
+	// Coalesced reports whether the response came from an identical request made by another caller.
+	Coalesced bool
+}
+
+// Handler sends a Request and returns its Response.
+// For non-2xx responses it returns both the Response and a *TMDBError.
+type Handler func(ctx context.Context, req *Request) (*Response, error)
+
+// Middleware wraps a Handler to run code around each request.
+type Middleware func(next Handler) Handler
+
+// Chain wraps h with mws. The first middleware is the outermost one,
+// i.e. it sees the request first and the response last.
+func Chain(h Handler, mws ...Middleware) Handler {
+	for i := len(mws) - 1; i >= 0; i-- {
+		h = mws[i](h)
+	}
+	return h
+}
+
+// MiddlewareFunc adapts a function that only needs to look at or change the request
+// and response into a Middleware.
+func MiddlewareFunc(fn func(ctx context.Context, req *Request, next Handler) (*Response, error)) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, req *Request) (*Response, error) {
+			return fn(ctx, req, next)
+		}
+	}
+}
+
+// * BUILT-IN MIDDLEWARES
+
+// LoggingMiddleware logs every request to logger. See Config.Logger for the emitted events.
+// Retries performed by RetryMiddleware further down the chain are logged too.
+func LoggingMiddleware(logger *slog.Logger) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, req *Request) (*Response, error) {
+			start := time.Now()
+			resp, err := next(withLogger(ctx, logger), req)
+			logRequest(ctx, logger, req, resp, start, err)
+			return resp, err
+		}
+	}
+}
+
+// RetryMiddleware resends failed requests according to policy.
+// Place it before RateLimitMiddleware so that every attempt waits for the limiter.
+func RetryMiddleware(policy *RetryPolicy) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, req *Request) (*Response, error) {
+			var resp *Response
+			var err error
+			for req.Attempt = 1; ; req.Attempt++ {
+				resp, err = next(ctx, req)
+				wait, retry := policy.next(ctx, req.Method, req.Attempt, resp, err)
+				if !retry {
+					break
+				}
+				logRetry(ctx, req, resp, wait, err)
+				if sleepErr := sleepContext(ctx, wait); sleepErr != nil {
+					// Report the last failure rather than the interrupted wait
+					if err == nil {
+						err = sleepErr
+					}
+					break
+				}
+			}
+
+			if req.Attempt > 1 {
+				var apiErr *TMDBError
+				if errors.As(err, &apiErr) {
+					apiErr.Attempts = req.Attempt
+				} else if err != nil {
+					err = fmt.Errorf("%w (after %d attempts)", err, req.Attempt)
+				}
+			}
+			return resp, err
+		}
+	}
+}
+
+// RateLimitMiddleware makes each request wait for limiter before being sent.
+func RateLimitMiddleware(limiter *RateLimiter) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, req *Request) (*Response, error) {
+			if err := limiter.Wait(ctx); err != nil {
+				return nil, fmt.Errorf("tmdb: waiting for rate limiter: %w", err)
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+// CacheMiddleware serves GET requests from cache and stores successful responses in it.
+// rules and defaultTTL work as Config.CacheRules and Config.CacheTTL.
+func CacheMiddleware(cache Cache, rules []CacheRule, defaultTTL time.Duration) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, req *Request) (*Response, error) {
+			mode := cacheModeFrom(ctx)
+			if !cacheable(req, mode) {
+				return next(ctx, req)
+			}
+			ttl := cacheTTL(rules, defaultTTL, req.Path)
+			if ttl <= 0 {
+				return next(ctx, req)
+			}
+
+			key := buildCacheKey(req.Method, req.Path, req.Query)
+			if mode != CacheRefresh {
+				if body, ok := cache.Get(key); ok {
+					return &Response{StatusCode: http.StatusOK, Body: body, CacheHit: true}, nil
+				}
+			}
+
+			resp, err := next(ctx, req)
+			if err == nil {
+				cache.Set(key, resp.Body, ttl)
+			}
+			return resp, err
+		}
+	}
+}
+
+// decodeMiddleware decodes successful responses into the request's Result.
+func decodeMiddleware(next Handler) Handler {
+	return func(ctx context.Context, req *Request) (*Response, error) {
+		resp, err := next(ctx, req)
+		if err != nil {
+			return resp, err
+		}
+		if err := decodeInto(resp, req.Result); err != nil {
+			return resp, err
+		}
+		return resp, nil
+	}
+}
+
+// decodeInto decodes resp's body into result unless that was already done.
+func decodeInto(resp *Response, result any) error {
+	if resp == nil || resp.Value != nil || result == nil {
+		return nil
+	}
+	if err := decodeResponse(resp.Body, result); err != nil {
+		return err
+	}
+	resp.Value = result
+	return nil
+}
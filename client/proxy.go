@@ -0,0 +1,179 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// ProxyRotation selects how requests are spread over Config.Proxies.
+type ProxyRotation int
+
+const (
+	// RoundRobin uses the proxies one after the other.
+	RoundRobin ProxyRotation = iota
+	// RandomProxy picks a random healthy proxy for each request.
+	RandomProxy
+)
+
+const (
+	defaultProxyMaxFailures = 3
+	defaultProxyEjectFor    = 30 * time.Second
+)
+
+// proxyPool hands out proxies and keeps track of their health.
+// A proxy failing ProxyMaxFailures times in a row is ejected for ProxyEjectFor.
+type proxyPool struct {
+	mu          sync.Mutex
+	proxies     []*proxyState
+	next        int
+	rotation    ProxyRotation
+	maxFailures int
+	ejectFor    time.Duration
+}
+
+type proxyState struct {
+	url          *url.URL
+	failures     int
+	ejectedUntil time.Time
+}
+
+func newProxyPool(config Config) (*proxyPool, error) {
+	pool := &proxyPool{
+		rotation:    config.ProxyRotation,
+		maxFailures: config.ProxyMaxFailures,
+		ejectFor:    config.ProxyEjectFor,
+	}
+	if pool.maxFailures <= 0 {
+		pool.maxFailures = defaultProxyMaxFailures
+	}
+	if pool.ejectFor <= 0 {
+		pool.ejectFor = defaultProxyEjectFor
+	}
+
+	for _, raw := range config.Proxies {
+		u, err := url.Parse(raw)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return nil, fmt.Errorf("tmdb: invalid proxy URL %q", redactProxy(raw))
+		}
+		pool.proxies = append(pool.proxies, &proxyState{url: u})
+	}
+	return pool, nil
+}
+
+// pick returns the proxy to use for the next request.
+// If every proxy is ejected, the one coming back the soonest is used rather than failing the request.
+func (p *proxyPool) pick() *proxyState {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	var healthy []*proxyState
+	for _, proxy := range p.proxies {
+		if !now.Before(proxy.ejectedUntil) {
+			healthy = append(healthy, proxy)
+		}
+	}
+
+	if len(healthy) == 0 {
+		soonest := p.proxies[0]
+		for _, proxy := range p.proxies[1:] {
+			if proxy.ejectedUntil.Before(soonest.ejectedUntil) {
+				soonest = proxy
+			}
+		}
+		return soonest
+	}
+
+	if p.rotation == RandomProxy {
+		return healthy[rand.IntN(len(healthy))]
+	}
+	proxy := healthy[p.next%len(healthy)]
+	p.next++
+	return proxy
+}
+
+// report records the outcome of a request sent through proxy.
+func (p *proxyPool) report(proxy *proxyState, ok bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if ok {
+		proxy.failures = 0
+		return
+	}
+	proxy.failures++
+	if proxy.failures >= p.maxFailures {
+		proxy.ejectedUntil = time.Now().Add(p.ejectFor)
+		proxy.failures = 0
+	}
+}
+
+// newTransport builds the transport used when Config.UseProxy is set.
+func newTransport(config Config) (http.RoundTripper, error) {
+	if len(config.Proxies) == 0 {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.Proxy = http.ProxyFromEnvironment
+		return transport, nil
+	}
+
+	pool, err := newProxyPool(config)
+	if err != nil {
+		return nil, err
+	}
+	return newProxyTransport(pool), nil
+}
+
+type proxyKey struct{}
+
+// proxyTransport routes each request through a proxy from the pool
+// and reports connection failures back to it.
+type proxyTransport struct {
+	pool *proxyPool
+	base *http.Transport
+}
+
+func newProxyTransport(pool *proxyPool) *proxyTransport {
+	base := http.DefaultTransport.(*http.Transport).Clone()
+	base.Proxy = func(req *http.Request) (*url.URL, error) {
+		if proxy, ok := req.Context().Value(proxyKey{}).(*proxyState); ok {
+			return proxy.url, nil
+		}
+		return nil, nil
+	}
+	return &proxyTransport{pool: pool, base: base}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *proxyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	proxy := t.pool.pick()
+	req = req.WithContext(context.WithValue(req.Context(), proxyKey{}, proxy))
+
+	resp, err := t.base.RoundTrip(req)
+	switch {
+	case err != nil:
+		// A canceled request says nothing about the proxy's health
+		if req.Context().Err() == nil && !errors.Is(err, context.Canceled) {
+			t.pool.report(proxy, false)
+		}
+	case resp.StatusCode == http.StatusProxyAuthRequired:
+		t.pool.report(proxy, false)
+	default:
+		t.pool.report(proxy, true)
+	}
+	return resp, err
+}
+
+// redactProxy hides the credentials of a proxy URL for error messages.
+func redactProxy(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "(unparsable)"
+	}
+	return u.Redacted()
+}
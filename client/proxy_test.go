@@ -0,0 +1,193 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// proxyServer starts a forward proxy answering every request itself, with an empty JSON object
+// or with status when it isn't 200. It counts the requests going through it.
+func proxyServer(t *testing.T, status int) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.URL.Host != "tmdb.test" {
+			t.Errorf("the proxy got a request for %q, want tmdb.test", r.URL.Host)
+		}
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &requests
+}
+
+// deadProxy returns the URL of a proxy refusing connections.
+func deadProxy() string {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	srv.Close()
+	return srv.URL
+}
+
+func newProxiedClient(t *testing.T, config Config) *Client {
+	t.Helper()
+	config.APIKey = "key"
+	config.BaseURL = "http://tmdb.test/3"
+	config.UseProxy = true
+	c, err := New(config)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	return c
+}
+
+func getMovie(c *Client) error {
+	return c.DoRequestContext(context.Background(), http.MethodGet, "/movie/550", nil, nil, nil)
+}
+
+func TestProxyRoundRobin(t *testing.T) {
+	pa, aRequests := proxyServer(t, http.StatusOK)
+	pb, bRequests := proxyServer(t, http.StatusOK)
+	pc, cRequests := proxyServer(t, http.StatusOK)
+	counters := []*atomic.Int32{aRequests, bRequests, cRequests}
+	c := newProxiedClient(t, Config{Proxies: []string{pa.URL, pb.URL, pc.URL}})
+
+	for i := range 6 {
+		before := counters[i%3].Load()
+		if err := getMovie(c); err != nil {
+			t.Fatalf("request %d: %v", i, err)
+		}
+		if counters[i%3].Load() != before+1 {
+			t.Errorf("request %d didn't go through proxy %d", i, i%3)
+		}
+	}
+}
+
+func TestProxyRandom(t *testing.T) {
+	pa, aRequests := proxyServer(t, http.StatusOK)
+	pb, bRequests := proxyServer(t, http.StatusOK)
+	pc, cRequests := proxyServer(t, http.StatusOK)
+	c := newProxiedClient(t, Config{Proxies: []string{pa.URL, pb.URL, pc.URL}, ProxyRotation: RandomProxy})
+
+	for range 60 {
+		if err := getMovie(c); err != nil {
+			t.Fatalf("GET: %v", err)
+		}
+	}
+	for i, n := range []int32{aRequests.Load(), bRequests.Load(), cRequests.Load()} {
+		if n == 0 {
+			t.Errorf("proxy %d was never picked in 60 requests", i)
+		}
+	}
+}
+
+func TestProxyEjection(t *testing.T) {
+	good, goodRequests := proxyServer(t, http.StatusOK)
+	c := newProxiedClient(t, Config{Proxies: []string{deadProxy(), good.URL}, ProxyMaxFailures: 2, ProxyEjectFor: time.Hour})
+
+	// The dead proxy fails its two turns, then it's out of the rotation
+	for i, wantErr := range []bool{true, false, true} {
+		if err := getMovie(c); (err != nil) != wantErr {
+			t.Fatalf("request %d: err = %v, want an error: %t", i, err, wantErr)
+		}
+	}
+	for range 4 {
+		if err := getMovie(c); err != nil {
+			t.Fatalf("GET after the ejection: %v", err)
+		}
+	}
+	if n := goodRequests.Load(); n != 5 {
+		t.Errorf("the healthy proxy got %d requests, want 5", n)
+	}
+}
+
+func TestProxyAuthRequired(t *testing.T) {
+	denied, deniedRequests := proxyServer(t, http.StatusProxyAuthRequired)
+	good, _ := proxyServer(t, http.StatusOK)
+	c := newProxiedClient(t, Config{Proxies: []string{denied.URL, good.URL}, ProxyMaxFailures: 1, ProxyEjectFor: time.Hour})
+
+	var apiErr *TMDBError
+	if err := getMovie(c); !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusProxyAuthRequired {
+		t.Fatalf("err = %v, want the proxy's 407", err)
+	}
+
+	// The 407 counted as a failure and ejected the proxy
+	for range 4 {
+		if err := getMovie(c); err != nil {
+			t.Fatalf("GET: %v", err)
+		}
+	}
+	if n := deniedRequests.Load(); n != 1 {
+		t.Errorf("the proxy requiring authentication got %d requests, want 1", n)
+	}
+}
+
+func TestProxyAllEjected(t *testing.T) {
+	a, aRequests := proxyServer(t, http.StatusProxyAuthRequired)
+	b, bRequests := proxyServer(t, http.StatusProxyAuthRequired)
+	c := newProxiedClient(t, Config{Proxies: []string{a.URL, b.URL}, ProxyMaxFailures: 1, ProxyEjectFor: time.Hour})
+
+	// Once both are ejected, requests go through the one coming back the soonest rather than failing outright.
+	// Each failure pushes that proxy's return back, so they take turns.
+	for range 4 {
+		if err := getMovie(c); !errors.Is(err, NewTMDBError(http.StatusProxyAuthRequired, "")) {
+			t.Fatalf("err = %v, want the proxy's 407", err)
+		}
+	}
+	if a, b := aRequests.Load(), bRequests.Load(); a != 2 || b != 2 {
+		t.Errorf("the proxies got %d and %d requests, want 2 each", a, b)
+	}
+}
+
+func TestProxyPoolFailures(t *testing.T) {
+	pool, err := newProxyPool(Config{Proxies: []string{"http://a:8080", "http://b:8080"}, ProxyMaxFailures: 2, ProxyEjectFor: 50 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("newProxyPool: %v", err)
+	}
+	a := pool.proxies[0]
+
+	// A success resets the count of consecutive failures
+	pool.report(a, false)
+	pool.report(a, true)
+	pool.report(a, false)
+	if !a.ejectedUntil.IsZero() {
+		t.Fatal("a was ejected after failures separated by a success")
+	}
+
+	pool.report(a, false)
+	if a.ejectedUntil.IsZero() {
+		t.Fatal("a wasn't ejected after 2 consecutive failures")
+	}
+	for range 3 {
+		if p := pool.pick(); p == a {
+			t.Fatal("picked the ejected proxy")
+		}
+	}
+
+	// It's back once ProxyEjectFor is over
+	time.Sleep(60 * time.Millisecond)
+	picked := map[*proxyState]bool{pool.pick(): true, pool.pick(): true}
+	if !picked[a] {
+		t.Error("a is still out of the rotation after ProxyEjectFor")
+	}
+}
+
+func TestInvalidProxy(t *testing.T) {
+	_, err := New(Config{APIKey: "key", UseProxy: true, Proxies: []string{"http://user:secret@/proxy"}})
+	if err == nil {
+		t.Fatal("New accepted a proxy URL without host")
+	}
+	if strings.Contains(err.Error(), "secret") {
+		t.Errorf("err = %v, want an error hiding the password", err)
+	}
+}
@@ -0,0 +1,90 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token bucket limiter that DoRequest waits on before each request.
+// It is safe for concurrent use and can be shared between several clients through Config.RateLimiter.
+type RateLimiter struct {
+	mu     sync.Mutex
+	rate   float64 // tokens added per second
+	burst  float64 // bucket capacity
+	tokens float64 // may go negative while callers are waiting for reserved tokens
+	last   time.Time
+}
+
+// NewRateLimiter creates a limiter allowing rps requests per second on average,
+// with bursts of up to burst requests. A burst below 1 is treated as 1.
+func NewRateLimiter(rps float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		rate:   rps,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a request is allowed or ctx is done.
+// If ctx is done first, the reserved slot is given back and ctx's error is returned.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	if l == nil || l.rate <= 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	l.tokens--
+	var wait time.Duration
+	if l.tokens < 0 {
+		wait = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	l.mu.Unlock()
+
+	if wait == 0 {
+		return nil
+	}
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+		l.cancel()
+		return fmt.Errorf("rate limit wait of %s exceeds context deadline: %w", wait, context.DeadlineExceeded)
+	}
+	if err := sleepContext(ctx, wait); err != nil {
+		l.cancel()
+		return err
+	}
+	return nil
+}
+
+// cancel returns a token reserved by a caller that stopped waiting.
+func (l *RateLimiter) cancel() {
+	l.mu.Lock()
+	l.tokens = min(l.burst, l.tokens+1)
+	l.mu.Unlock()
+}
+
+var (
+	sharedLimitersMu sync.Mutex
+	sharedLimiters   = map[string]*RateLimiter{}
+)
+
+// sharedRateLimiter returns the limiter registered for apiKey, creating it on first use.
+// Clients created later with the same key reuse the first limiter and its settings.
+func sharedRateLimiter(apiKey string, rps float64, burst int) *RateLimiter {
+	sharedLimitersMu.Lock()
+	defer sharedLimitersMu.Unlock()
+
+	if l, ok := sharedLimiters[apiKey]; ok {
+		return l
+	}
+	l := NewRateLimiter(rps, burst)
+	sharedLimiters[apiKey] = l
+	return l
+}
@@ -0,0 +1,200 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/falconer001/gotmdb/client"
+	"github.com/falconer001/gotmdb/tmdbtest"
+)
+
+// countingServer answers every request with an empty JSON object and counts them.
+func countingServer(t *testing.T) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &requests
+}
+
+func newLimitedClient(t *testing.T, config client.Config) *client.Client {
+	t.Helper()
+	c, err := client.New(config)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	return c
+}
+
+func TestRateLimiterBurst(t *testing.T) {
+	l := client.NewRateLimiter(20, 3)
+	ctx := context.Background()
+
+	start := time.Now()
+	for range 3 {
+		if err := l.Wait(ctx); err != nil {
+			t.Fatalf("Wait: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > 20*time.Millisecond {
+		t.Errorf("the burst took %s, want no wait", elapsed)
+	}
+
+	// The bucket is empty, the next token comes 50ms later
+	if err := l.Wait(ctx); err != nil {
+		t.Fatalf("Wait: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("the 4th request went after %s, want about 50ms", elapsed)
+	}
+}
+
+func TestRateLimiterDeadline(t *testing.T) {
+	l := client.NewRateLimiter(10, 1)
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait: %v", err)
+	}
+
+	// The next token is 100ms away, past the deadline: Wait fails without waiting
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if err := l.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 15*time.Millisecond {
+		t.Errorf("failed after %s, want no wait", elapsed)
+	}
+
+	// The token reserved by the failed call was given back
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 170*time.Millisecond {
+		t.Errorf("the next request went after %s, want about 100ms", elapsed)
+	}
+}
+
+func TestRateLimiterCanceled(t *testing.T) {
+	l := client.NewRateLimiter(1, 1)
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+	if err := l.Wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	srv, requests := countingServer(t)
+	c := newLimitedClient(t, client.Config{APIKey: "key", BaseURL: srv.URL, RateLimit: 20, RateBurst: 2})
+
+	// The burst goes through at once, the 4 other requests wait 50ms each
+	start := time.Now()
+	var wg sync.WaitGroup
+	for range 6 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := c.DoRequestContext(context.Background(), http.MethodGet, "/movie/550", nil, nil, nil); err != nil {
+				t.Errorf("GET /movie/550: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if elapsed := time.Since(start); elapsed < 180*time.Millisecond {
+		t.Errorf("6 requests took %s, want at least 200ms", elapsed)
+	}
+	if n := requests.Load(); n != 6 {
+		t.Errorf("sent %d requests, want 6", n)
+	}
+}
+
+func TestRateLimitContextDeadline(t *testing.T) {
+	srv, requests := countingServer(t)
+	c := newLimitedClient(t, client.Config{APIKey: "key", BaseURL: srv.URL, RateLimit: 1, RateBurst: 1})
+
+	if err := c.DoRequestContext(context.Background(), http.MethodGet, "/movie/550", nil, nil, nil); err != nil {
+		t.Fatalf("first request: %v", err)
+	}
+
+	// The next slot is a second away, past the deadline: the request fails without waiting
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err := c.DoRequestContext(ctx, http.MethodGet, "/movie/550", nil, nil, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Errorf("failed after %s, want no wait", elapsed)
+	}
+	if n := requests.Load(); n != 1 {
+		t.Errorf("sent %d requests, want 1", n)
+	}
+}
+
+func TestSharedRateLimiter(t *testing.T) {
+	srv, _ := countingServer(t)
+
+	// Clients with the same key share the first client's limiter and settings
+	a := newLimitedClient(t, client.Config{APIKey: "shared-key", BaseURL: srv.URL, RateLimit: 20, RateBurst: 1, ShareRateLimiter: true})
+	b := newLimitedClient(t, client.Config{APIKey: "shared-key", BaseURL: srv.URL, RateLimit: 1000, RateBurst: 100, ShareRateLimiter: true})
+	other := newLimitedClient(t, client.Config{APIKey: "other-key", BaseURL: srv.URL, RateLimit: 20, RateBurst: 1, ShareRateLimiter: true})
+	ctx := context.Background()
+
+	start := time.Now()
+	for _, c := range []*client.Client{a, b, a, b} {
+		if err := c.DoRequestContext(ctx, http.MethodGet, "/movie/550", nil, nil, nil); err != nil {
+			t.Fatalf("GET /movie/550: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 130*time.Millisecond {
+		t.Errorf("4 requests through the shared limiter took %s, want at least 150ms", elapsed)
+	}
+
+	// Another key has its own limiter
+	start = time.Now()
+	if err := other.DoRequestContext(ctx, http.MethodGet, "/movie/550", nil, nil, nil); err != nil {
+		t.Fatalf("GET /movie/550: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 30*time.Millisecond {
+		t.Errorf("a request with another key waited %s, want no wait", elapsed)
+	}
+}
+
+func TestRateLimitRetries(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	c := newClient(t, srv, func(config *client.Config) {
+		config.Retry = fastRetry(4)
+		config.RateLimit = 20
+		config.RateBurst = 1
+	})
+
+	// Every retry waits for the limiter too
+	srv.RateLimit(3, 0)
+	start := time.Now()
+	if err := c.DoRequestContext(context.Background(), http.MethodGet, "/movie/550", nil, nil, nil); err != nil {
+		t.Fatalf("GET /movie/550: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 130*time.Millisecond {
+		t.Errorf("4 attempts took %s, want at least 150ms", elapsed)
+	}
+	if n := len(srv.Requests()); n != 4 {
+		t.Errorf("sent %d requests, want 4", n)
+	}
+}
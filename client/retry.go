@@ -0,0 +1,180 @@
+package client
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how DoRequest retries failed requests.
+// A nil policy (the default) disables retries entirely.
+//
+// Requests are retried on network errors and on the status codes listed in RetryOn.
+// Only idempotent methods (GET, HEAD, OPTIONS) are retried on 5xx responses and network errors,
+// because a POST or DELETE (e.g. rating a movie) may already have been applied by TMDb.
+// A 429 is always retried since TMDb rejects the request before processing it.
+// Set RetryNonIdempotent to also replay POST/DELETE calls in every case.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// Values below 2 disable retries.
+	MaxAttempts int
+
+	// InitialBackoff is the wait before the first retry. Defaults to 500ms.
+	InitialBackoff time.Duration
+
+	// MaxBackoff caps the wait between two attempts, including waits requested through Retry-After.
+	// If TMDb asks for a longer wait, the request is not retried. Defaults to 30 seconds.
+	MaxBackoff time.Duration
+
+	// Multiplier is applied to the backoff after each attempt. Defaults to 2.
+	Multiplier float64
+
+	// Jitter is the fraction (0 to 1) of each backoff that is randomized,
+	// so concurrent clients don't retry in lockstep. Defaults to 0 (no jitter).
+	Jitter float64
+
+	// RetryOn lists the HTTP status codes that trigger a retry.
+	// Defaults to 429, 500, 502, 503 and 504.
+	RetryOn []int
+
+	// RetryNonIdempotent allows retrying POST and DELETE requests on 5xx responses and network errors.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns a policy suited to TMDb's rate limiting:
+// up to 4 attempts, starting at 500ms and doubling, with 20% jitter.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    4,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     30 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+	}
+}
+
+var defaultRetryOn = []int{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// backoff returns the wait before the given retry (1 for the first retry).
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	initial := p.InitialBackoff
+	if initial <= 0 {
+		initial = 500 * time.Millisecond
+	}
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 2
+	}
+
+	d := float64(initial) * math.Pow(multiplier, float64(retry-1))
+	if ceiling := float64(p.maxBackoff()); d > ceiling {
+		d = ceiling
+	}
+	if p.Jitter > 0 {
+		jitter := math.Min(p.Jitter, 1)
+		d -= d * jitter * rand.Float64()
+	}
+	return time.Duration(d)
+}
+
+func (p *RetryPolicy) maxBackoff() time.Duration {
+	if p.MaxBackoff <= 0 {
+		return 30 * time.Second
+	}
+	return p.MaxBackoff
+}
+
+// next decides whether attempt should be followed by another one, and how long to wait first.
+// resp is nil when the attempt failed before getting a response (err).
+// ctx is the caller's context: an attempt that timed out on its own is retried, but not once ctx is done.
+func (p *RetryPolicy) next(ctx context.Context, method string, attempt int, resp *Response, err error) (time.Duration, bool) {
+	if p == nil || attempt >= p.MaxAttempts {
+		return 0, false
+	}
+
+	idempotent := p.RetryNonIdempotent || isIdempotent(method)
+
+	if resp == nil {
+		if err == nil {
+			return 0, false
+		}
+		// The caller gave up, there's nothing to retry.
+		if ctx.Err() != nil {
+			return 0, false
+		}
+		if !idempotent {
+			return 0, false
+		}
+		return p.backoff(attempt), true
+	}
+
+	retryOn := p.RetryOn
+	if retryOn == nil {
+		retryOn = defaultRetryOn
+	}
+	if !slices.Contains(retryOn, resp.StatusCode) {
+		return 0, false
+	}
+	if resp.StatusCode != http.StatusTooManyRequests && !idempotent {
+		return 0, false
+	}
+
+	wait := p.backoff(attempt)
+	if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+		if retryAfter > p.maxBackoff() {
+			return 0, false
+		}
+		wait = max(wait, retryAfter)
+	}
+	return wait, true
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as an HTTP date.
+func parseRetryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return max(time.Until(t), 0), true
+	}
+	return 0, false
+}
+
+// sleepContext waits for d or until ctx is done, whichever comes first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,160 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/falconer001/gotmdb/client"
+	"github.com/falconer001/gotmdb/tmdbtest"
+)
+
+// fastRetry retries quickly so the tests don't wait on backoffs.
+func fastRetry(attempts int) *client.RetryPolicy {
+	return &client.RetryPolicy{
+		MaxAttempts:    attempts,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Second,
+	}
+}
+
+func newClient(t *testing.T, srv *tmdbtest.Server, configure func(*client.Config)) *client.Client {
+	t.Helper()
+	config := srv.Config()
+	if configure != nil {
+		configure(&config)
+	}
+	c, err := client.New(config)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	return c
+}
+
+func TestRetryRateLimitBurst(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	c := newClient(t, srv, func(config *client.Config) { config.Retry = fastRetry(4) })
+
+	srv.RateLimit(2, 0)
+	var movie struct{ ID int }
+	if err := c.DoRequestContext(context.Background(), http.MethodGet, "/movie/550", nil, nil, &movie); err != nil {
+		t.Fatalf("GET /movie/550: %v", err)
+	}
+	if movie.ID != 550 {
+		t.Errorf("ID = %d, want 550", movie.ID)
+	}
+	if n := len(srv.Requests()); n != 3 {
+		t.Errorf("sent %d requests, want 3", n)
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	c := newClient(t, srv, func(config *client.Config) { config.Retry = fastRetry(3) })
+
+	srv.RateLimit(5, 0)
+	err := c.DoRequestContext(context.Background(), http.MethodGet, "/movie/550", nil, nil, nil)
+	if !errors.Is(err, client.ErrRateLimited) {
+		t.Fatalf("err = %v, want ErrRateLimited", err)
+	}
+	var apiErr *client.TMDBError
+	if !errors.As(err, &apiErr) || apiErr.Attempts != 3 {
+		t.Errorf("err = %#v, want a TMDBError after 3 attempts", err)
+	}
+}
+
+func TestRetryAfterTooLong(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	c := newClient(t, srv, func(config *client.Config) { config.Retry = fastRetry(4) })
+
+	// Retry-After is past MaxBackoff, so the request isn't retried
+	srv.RateLimit(1, 5*time.Second)
+	err := c.DoRequestContext(context.Background(), http.MethodGet, "/movie/550", nil, nil, nil)
+	if !errors.Is(err, client.ErrRateLimited) {
+		t.Fatalf("err = %v, want ErrRateLimited", err)
+	}
+	if n := len(srv.Requests()); n != 1 {
+		t.Errorf("sent %d requests, want 1", n)
+	}
+}
+
+func TestRetryServerErrorIdempotentOnly(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	c := newClient(t, srv, func(config *client.Config) { config.Retry = fastRetry(3) })
+
+	srv.Fail("/movie/{id}/rating", http.StatusServiceUnavailable, 9, "Service offline: This service is temporarily offline, try again later.")
+
+	err := c.DoRequestContext(context.Background(), http.MethodPost, "/movie/550/rating", nil, map[string]float64{"value": 8}, nil)
+	if !errors.Is(err, client.ErrServiceUnavailable) {
+		t.Fatalf("POST: err = %v, want ErrServiceUnavailable", err)
+	}
+	if n := len(srv.Requests()); n != 1 {
+		t.Errorf("POST sent %d requests, want 1", n)
+	}
+
+	srv.Reset()
+	srv.Fail("GET /movie/{id}", http.StatusServiceUnavailable, 9, "Service offline: This service is temporarily offline, try again later.")
+	err = c.DoRequestContext(context.Background(), http.MethodGet, "/movie/550", nil, nil, nil)
+	if !errors.Is(err, client.ErrServiceUnavailable) {
+		t.Fatalf("GET: err = %v, want ErrServiceUnavailable", err)
+	}
+	if n := len(srv.Requests()); n != 3 {
+		t.Errorf("GET sent %d requests, want 3", n)
+	}
+}
+
+func TestRetryAttemptTimeout(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	c := newClient(t, srv, func(config *client.Config) {
+		config.Retry = fastRetry(3)
+		config.Timeout = 50 * time.Millisecond
+	})
+
+	// The first attempt hangs past the client timeout, the second one answers
+	var calls atomic.Int32
+	srv.Handle("GET /movie/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			select {
+			case <-time.After(time.Second):
+			case <-r.Context().Done():
+			}
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 550}`))
+	})
+
+	var movie struct{ ID int }
+	if err := c.DoRequestContext(context.Background(), http.MethodGet, "/movie/550", nil, nil, &movie); err != nil {
+		t.Fatalf("GET /movie/550: %v", err)
+	}
+	if movie.ID != 550 || calls.Load() != 2 {
+		t.Errorf("ID = %d after %d calls, want 550 after 2", movie.ID, calls.Load())
+	}
+}
+
+func TestRetryStopsWhenCallerGivesUp(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	c := newClient(t, srv, func(config *client.Config) { config.Retry = fastRetry(5) })
+
+	srv.SetLatency("GET /movie/{id}", time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := c.DoRequestContext(ctx, http.MethodGet, "/movie/550", nil, nil, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want context.DeadlineExceeded", err)
+	}
+	if n := len(srv.Requests()); n != 1 {
+		t.Errorf("sent %d requests, want 1", n)
+	}
+}
@@ -0,0 +1,77 @@
+package client
+
+import (
+	"strings"
+)
+
+// groupNames maps the first path segment to the name of the endpoint group it belongs to.
+// Segments not listed here are used as is.
+var groupNames = map[string]string{
+	"movie":          "movies",
+	"person":         "people",
+	"authentication": "auth",
+	"collection":     "collections",
+	"company":        "companies",
+	"network":        "networks",
+	"genre":          "genres",
+	"keyword":        "keywords",
+	"review":         "reviews",
+	"credit":         "credits",
+	"certification":  "certifications",
+}
+
+// idParents are segments always followed by an ID, even when that ID isn't numeric
+// (e.g. IMDb IDs, review and episode group object IDs).
+var idParents = map[string]bool{
+	"find":          true,
+	"review":        true,
+	"credit":        true,
+	"episode_group": true,
+}
+
+// Route describes the logical endpoint of an API path, for naming spans, metrics or log entries.
+// Template is the path with its IDs replaced by placeholders, e.g. "/movie/{id}/credits".
+// Name is a stable dotted name such as "tmdb.movies.credits"; paths ending with an ID
+// are named after their details endpoint, e.g. "/movie/550" gives "tmdb.movies.details".
+func Route(path string) (template, name string) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) == 0 || segments[0] == "" {
+		return "/", "tmdb"
+	}
+
+	tmpl := make([]string, 0, len(segments))
+	parts := []string{"tmdb"}
+	endsWithID := false
+	for i, seg := range segments {
+		if i > 0 && (isNumeric(seg) || idParents[segments[i-1]]) {
+			tmpl = append(tmpl, "{id}")
+			endsWithID = true
+			continue
+		}
+		endsWithID = false
+		tmpl = append(tmpl, seg)
+		if i == 0 {
+			if group, ok := groupNames[seg]; ok {
+				seg = group
+			}
+		}
+		parts = append(parts, seg)
+	}
+	if endsWithID {
+		parts = append(parts, "details")
+	}
+
+	return "/" + strings.Join(tmpl, "/"), strings.Join(parts, ".")
+}
+
+func isNumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,39 @@
+package client_test
+
+import (
+	"testing"
+
+	"github.com/falconer001/gotmdb/client"
+)
+
+func TestRoute(t *testing.T) {
+	tests := []struct {
+		path     string
+		template string
+		name     string
+	}{
+		{"/movie/550", "/movie/{id}", "tmdb.movies.details"},
+		{"/movie/550/credits", "/movie/{id}/credits", "tmdb.movies.credits"},
+		{"/movie/popular", "/movie/popular", "tmdb.movies.popular"},
+		{"/tv/1399/season/1/episode/2", "/tv/{id}/season/{id}/episode/{id}", "tmdb.tv.season.episode.details"},
+		{"/tv/1399/season/1/episode/2/images", "/tv/{id}/season/{id}/episode/{id}/images", "tmdb.tv.season.episode.images"},
+		{"/tv/episode_group/5acf93e60e0a26346d0000ce", "/tv/episode_group/{id}", "tmdb.tv.episode_group.details"},
+		{"/find/tt0137523", "/find/{id}", "tmdb.find.details"},
+		{"/review/5488c29bc3a3686f4a00004a", "/review/{id}", "tmdb.reviews.details"},
+		{"/credit/52542282760ee313280017f9", "/credit/{id}", "tmdb.credits.details"},
+		{"/person/287/combined_credits", "/person/{id}/combined_credits", "tmdb.people.combined_credits"},
+		{"/genre/movie/list", "/genre/movie/list", "tmdb.genres.movie.list"},
+		{"/authentication/token/new", "/authentication/token/new", "tmdb.auth.token.new"},
+		{"/account/42/favorite/movies", "/account/{id}/favorite/movies", "tmdb.account.favorite.movies"},
+		{"/search/movie", "/search/movie", "tmdb.search.movie"},
+		{"/configuration", "/configuration", "tmdb.configuration"},
+		{"/", "/", "tmdb"},
+		{"", "/", "tmdb"},
+	}
+	for _, tt := range tests {
+		template, name := client.Route(tt.path)
+		if template != tt.template || name != tt.name {
+			t.Errorf("Route(%q) = %q, %q, want %q, %q", tt.path, template, name, tt.template, tt.name)
+		}
+	}
+}
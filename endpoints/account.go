@@ -0,0 +1,82 @@
+package endpoints
+
+import (
+	"fmt"
+
+	"github.com/falconer001/gotmdb/client"
+	opts "github.com/falconer001/gotmdb/options"
+	"github.com/falconer001/gotmdb/types"
+)
+
+// Account handles communication with the account related methods of the TMDb API.
+// Every method works with either the client's bearer token or a session ID (set with .SessionID).
+// See: https://developer.themoviedb.org/reference/account-details
+type Account struct {
+	Client *client.Client
+}
+
+// GetDetails retrieves the public details of an account.
+// See: https://developer.themoviedb.org/reference/account-details
+func (a *Account) GetDetails(accountID int) *opts.StateSessionBuilder[*types.AccountDetails] {
+	return opts.NewStateSessionBuilder[*types.AccountDetails](a.Client, fmt.Sprintf("/account/%d", accountID), "GET", nil)
+}
+
+// AddFavorite marks a movie or TV show as a favorite, or unmarks it with Favorite set to false.
+// See: https://developer.themoviedb.org/reference/account-add-favorite
+func (a *Account) AddFavorite(accountID int, body types.MarkFavoriteRequest) *opts.StateSessionBuilder[*types.StatusResponse] {
+	return opts.NewStateSessionBuilder[*types.StatusResponse](a.Client, fmt.Sprintf("/account/%d/favorite", accountID), "POST", body)
+}
+
+// AddToWatchlist adds a movie or TV show to the watchlist, or removes it with Watchlist set to false.
+// See: https://developer.themoviedb.org/reference/account-add-to-watchlist
+func (a *Account) AddToWatchlist(accountID int, body types.AddToWatchlistRequest) *opts.StateSessionBuilder[*types.StatusResponse] {
+	return opts.NewStateSessionBuilder[*types.StatusResponse](a.Client, fmt.Sprintf("/account/%d/watchlist", accountID), "POST", body)
+}
+
+// GetFavoriteMovies retrieves the movies marked as favorite.
+// See: https://developer.themoviedb.org/reference/account-get-favorites
+func (a *Account) GetFavoriteMovies(accountID int) *opts.AccountListBuilder[*types.MoviePaginatedResults] {
+	return opts.NewAccountListBuilder[*types.MoviePaginatedResults](a.Client, fmt.Sprintf("/account/%d/favorite/movies", accountID))
+}
+
+// GetFavoriteTV retrieves the TV shows marked as favorite.
+// See: https://developer.themoviedb.org/reference/account-favorite-tv
+func (a *Account) GetFavoriteTV(accountID int) *opts.AccountListBuilder[*types.TVShowPaginatedResults] {
+	return opts.NewAccountListBuilder[*types.TVShowPaginatedResults](a.Client, fmt.Sprintf("/account/%d/favorite/tv", accountID))
+}
+
+// GetWatchlistMovies retrieves the movies on the watchlist.
+// See: https://developer.themoviedb.org/reference/account-watchlist-movies
+func (a *Account) GetWatchlistMovies(accountID int) *opts.AccountListBuilder[*types.MoviePaginatedResults] {
+	return opts.NewAccountListBuilder[*types.MoviePaginatedResults](a.Client, fmt.Sprintf("/account/%d/watchlist/movies", accountID))
+}
+
+// GetWatchlistTV retrieves the TV shows on the watchlist.
+// See: https://developer.themoviedb.org/reference/account-watchlist-tv
+func (a *Account) GetWatchlistTV(accountID int) *opts.AccountListBuilder[*types.TVShowPaginatedResults] {
+	return opts.NewAccountListBuilder[*types.TVShowPaginatedResults](a.Client, fmt.Sprintf("/account/%d/watchlist/tv", accountID))
+}
+
+// GetRatedMovies retrieves the movies rated by the account, along with their rating.
+// See: https://developer.themoviedb.org/reference/account-rated-movies
+func (a *Account) GetRatedMovies(accountID int) *opts.AccountListBuilder[*types.RatedMoviePaginatedResults] {
+	return opts.NewAccountListBuilder[*types.RatedMoviePaginatedResults](a.Client, fmt.Sprintf("/account/%d/rated/movies", accountID))
+}
+
+// GetRatedTV retrieves the TV shows rated by the account, along with their rating.
+// See: https://developer.themoviedb.org/reference/account-rated-tv
+func (a *Account) GetRatedTV(accountID int) *opts.AccountListBuilder[*types.RatedTVShowPaginatedResults] {
+	return opts.NewAccountListBuilder[*types.RatedTVShowPaginatedResults](a.Client, fmt.Sprintf("/account/%d/rated/tv", accountID))
+}
+
+// GetRatedTVEpisodes retrieves the TV episodes rated by the account, along with their rating.
+// See: https://developer.themoviedb.org/reference/account-rated-tv-episodes
+func (a *Account) GetRatedTVEpisodes(accountID int) *opts.AccountListBuilder[*types.RatedTVEpisodePaginatedResults] {
+	return opts.NewAccountListBuilder[*types.RatedTVEpisodePaginatedResults](a.Client, fmt.Sprintf("/account/%d/rated/tv/episodes", accountID))
+}
+
+// GetLists retrieves the lists created by the account.
+// See: https://developer.themoviedb.org/reference/account-lists
+func (a *Account) GetLists(accountID int) *opts.AccountListBuilder[*types.ListPaginatedResults] {
+	return opts.NewAccountListBuilder[*types.ListPaginatedResults](a.Client, fmt.Sprintf("/account/%d/lists", accountID))
+}
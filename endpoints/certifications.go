@@ -0,0 +1,25 @@
+package endpoints
+
+import (
+	"github.com/falconer001/gotmdb/client"
+	opts "github.com/falconer001/gotmdb/options"
+	"github.com/falconer001/gotmdb/types"
+)
+
+// Certifications handles communication with the certification related methods of the TMDb API.
+// See: https://developer.themoviedb.org/reference/certification-movie-list
+type Certifications struct {
+	Client *client.Client
+}
+
+// GetMovieCertifications retrieves the movie certifications (e.g. "PG-13") of every country.
+// See: https://developer.themoviedb.org/reference/certification-movie-list
+func (c *Certifications) GetMovieCertifications() *opts.NoOptsBuilder[*types.CertificationsResponse] {
+	return opts.NewNoOptsBuilder[*types.CertificationsResponse](c.Client, "/certification/movie/list")
+}
+
+// GetTVCertifications retrieves the TV certifications (e.g. "TV-MA") of every country.
+// See: https://developer.themoviedb.org/reference/certifications-tv-list
+func (c *Certifications) GetTVCertifications() *opts.NoOptsBuilder[*types.CertificationsResponse] {
+	return opts.NewNoOptsBuilder[*types.CertificationsResponse](c.Client, "/certification/tv/list")
+}
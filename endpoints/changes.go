@@ -0,0 +1,36 @@
+package endpoints
+
+import (
+	"github.com/falconer001/gotmdb/client"
+	opts "github.com/falconer001/gotmdb/options"
+)
+
+// Changes handles communication with the global change lists of the TMDb API:
+// the IDs of the movies, TV shows and people edited during a period.
+// The changes of a single item are returned by the GetChanges method of its endpoint.
+// See the tmdbsync package to keep a local copy up to date with them.
+// See: https://developer.themoviedb.org/reference/changes-movie-list
+type Changes struct {
+	Client *client.Client
+}
+
+// GetMovieChanges retrieves the IDs of the movies changed in the last 24 hours, or within DateRange.
+// You can query up to 14 days in a single query.
+// See: https://developer.themoviedb.org/reference/changes-movie-list
+func (c *Changes) GetMovieChanges() *opts.ChangeListBuilder {
+	return opts.NewChangeListBuilder(c.Client, "/movie/changes")
+}
+
+// GetTVChanges retrieves the IDs of the TV shows changed in the last 24 hours, or within DateRange.
+// You can query up to 14 days in a single query.
+// See: https://developer.themoviedb.org/reference/changes-tv-list
+func (c *Changes) GetTVChanges() *opts.ChangeListBuilder {
+	return opts.NewChangeListBuilder(c.Client, "/tv/changes")
+}
+
+// GetPersonChanges retrieves the IDs of the people changed in the last 24 hours, or within DateRange.
+// You can query up to 14 days in a single query.
+// See: https://developer.themoviedb.org/reference/changes-people-list
+func (c *Changes) GetPersonChanges() *opts.ChangeListBuilder {
+	return opts.NewChangeListBuilder(c.Client, "/person/changes")
+}
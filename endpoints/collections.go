@@ -0,0 +1,35 @@
+package endpoints
+
+import (
+	"fmt"
+
+	"github.com/falconer001/gotmdb/client"
+	opts "github.com/falconer001/gotmdb/options"
+	"github.com/falconer001/gotmdb/types"
+)
+
+// Collections handles communication with the collection related methods of the TMDb API.
+// See: https://developer.themoviedb.org/reference/collection-details
+type Collections struct {
+	Client *client.Client
+}
+
+// GetDetails retrieves the details of a collection, along with its movies.
+// See: https://developer.themoviedb.org/reference/collection-details
+func (c *Collections) GetDetails(collectionID int) *opts.LangBuilder[*types.CollectionDetails] {
+	return opts.NewLangBuilder[*types.CollectionDetails](c.Client, fmt.Sprintf("/collection/%d", collectionID))
+}
+
+// GetImages retrieves the images that belong to a collection.
+// Querying images with a language parameter will filter the results.
+// If you want to include a fallback language (like English) you can use the include_image_language parameter.
+// See: https://developer.themoviedb.org/reference/collection-images
+func (c *Collections) GetImages(collectionID int) *opts.LangBuilder[*types.CollectionImagesResponse] {
+	return opts.NewLangBuilder[*types.CollectionImagesResponse](c.Client, fmt.Sprintf("/collection/%d/images", collectionID))
+}
+
+// GetTranslations retrieves the translations that have been created for a collection.
+// See: https://developer.themoviedb.org/reference/collection-translations
+func (c *Collections) GetTranslations(collectionID int) *opts.NoOptsBuilder[*types.CollectionTranslationsResponse] {
+	return opts.NewNoOptsBuilder[*types.CollectionTranslationsResponse](c.Client, fmt.Sprintf("/collection/%d/translations", collectionID))
+}
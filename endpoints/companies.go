@@ -0,0 +1,33 @@
+package endpoints
+
+import (
+	"fmt"
+
+	"github.com/falconer001/gotmdb/client"
+	opts "github.com/falconer001/gotmdb/options"
+	"github.com/falconer001/gotmdb/types"
+)
+
+// Companies handles communication with the company related methods of the TMDb API.
+// See: https://developer.themoviedb.org/reference/company-details
+type Companies struct {
+	Client *client.Client
+}
+
+// GetDetails retrieves the details of a company.
+// See: https://developer.themoviedb.org/reference/company-details
+func (c *Companies) GetDetails(companyID int) *opts.NoOptsBuilder[*types.CompanyDetails] {
+	return opts.NewNoOptsBuilder[*types.CompanyDetails](c.Client, fmt.Sprintf("/company/%d", companyID))
+}
+
+// GetAlternativeNames retrieves the alternative names of a company.
+// See: https://developer.themoviedb.org/reference/company-alternative-names
+func (c *Companies) GetAlternativeNames(companyID int) *opts.NoOptsBuilder[*types.CompanyAlternativeNamesResponse] {
+	return opts.NewNoOptsBuilder[*types.CompanyAlternativeNamesResponse](c.Client, fmt.Sprintf("/company/%d/alternative_names", companyID))
+}
+
+// GetImages retrieves the logos of a company.
+// See: https://developer.themoviedb.org/reference/company-images
+func (c *Companies) GetImages(companyID int) *opts.NoOptsBuilder[*types.CompanyImagesResponse] {
+	return opts.NewNoOptsBuilder[*types.CompanyImagesResponse](c.Client, fmt.Sprintf("/company/%d/images", companyID))
+}
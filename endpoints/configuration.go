@@ -0,0 +1,50 @@
+package endpoints
+
+import (
+	"github.com/falconer001/gotmdb/client"
+	opts "github.com/falconer001/gotmdb/options"
+	"github.com/falconer001/gotmdb/types"
+)
+
+// Configuration handles communication with the configuration related methods of the TMDb API.
+// This data rarely changes; see Reference for a cached view of it.
+// See: https://developer.themoviedb.org/reference/configuration-details
+type Configuration struct {
+	Client *client.Client
+}
+
+// GetDetails retrieves the API configuration: the image base URLs and sizes, and the change keys.
+// See: https://developer.themoviedb.org/reference/configuration-details
+func (c *Configuration) GetDetails() *opts.NoOptsBuilder[*types.APIConfiguration] {
+	return opts.NewNoOptsBuilder[*types.APIConfiguration](c.Client, "/configuration")
+}
+
+// GetCountries retrieves the list of countries (ISO 3166-1 tags) used throughout TMDb.
+// See: https://developer.themoviedb.org/reference/configuration-countries
+func (c *Configuration) GetCountries() *opts.LanguageBuilder[[]types.Country] {
+	return opts.NewLanguageBuilder[[]types.Country](c.Client, "/configuration/countries")
+}
+
+// GetJobs retrieves the list of departments and jobs used throughout TMDb.
+// See: https://developer.themoviedb.org/reference/configuration-jobs
+func (c *Configuration) GetJobs() *opts.NoOptsBuilder[[]types.JobDepartment] {
+	return opts.NewNoOptsBuilder[[]types.JobDepartment](c.Client, "/configuration/jobs")
+}
+
+// GetLanguages retrieves the list of languages (ISO 639-1 tags) used throughout TMDb.
+// See: https://developer.themoviedb.org/reference/configuration-languages
+func (c *Configuration) GetLanguages() *opts.NoOptsBuilder[[]types.LanguageConfig] {
+	return opts.NewNoOptsBuilder[[]types.LanguageConfig](c.Client, "/configuration/languages")
+}
+
+// GetPrimaryTranslations retrieves the officially supported translations, e.g. "en-US", "fr-FR".
+// See: https://developer.themoviedb.org/reference/configuration-primary-translations
+func (c *Configuration) GetPrimaryTranslations() *opts.NoOptsBuilder[[]string] {
+	return opts.NewNoOptsBuilder[[]string](c.Client, "/configuration/primary_translations")
+}
+
+// GetTimezones retrieves the list of timezones used throughout TMDb, grouped by country.
+// See: https://developer.themoviedb.org/reference/configuration-timezones
+func (c *Configuration) GetTimezones() *opts.NoOptsBuilder[[]types.Timezone] {
+	return opts.NewNoOptsBuilder[[]types.Timezone](c.Client, "/configuration/timezones")
+}
@@ -0,0 +1,23 @@
+package endpoints
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/falconer001/gotmdb/client"
+	opts "github.com/falconer001/gotmdb/options"
+	"github.com/falconer001/gotmdb/types"
+)
+
+// Credits handles communication with the credit related methods of the TMDb API.
+// See: https://developer.themoviedb.org/reference/credit-details
+type Credits struct {
+	Client *client.Client
+}
+
+// GetDetails retrieves a credit: the person, the movie or TV show, and the job or character.
+// Credit IDs are found in the credits of movies, TV shows and people (CreditID).
+// See: https://developer.themoviedb.org/reference/credit-details
+func (c *Credits) GetDetails(creditID string) *opts.NoOptsBuilder[*types.CreditDetails] {
+	return opts.NewNoOptsBuilder[*types.CreditDetails](c.Client, fmt.Sprintf("/credit/%s", url.PathEscape(creditID)))
+}
@@ -0,0 +1,23 @@
+package endpoints
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/falconer001/gotmdb/client"
+	opts "github.com/falconer001/gotmdb/options"
+	"github.com/falconer001/gotmdb/types"
+)
+
+// Find handles finding TMDb objects by the IDs other databases give them.
+// See: https://developer.themoviedb.org/reference/find-by-id
+type Find struct {
+	Client *client.Client
+}
+
+// ByExternalID finds the movies, TV shows, seasons, episodes and people with an external ID,
+// e.g. ByExternalID("tt0137523", types.ExternalSourceIMDb).
+// See: https://developer.themoviedb.org/reference/find-by-id
+func (f *Find) ByExternalID(externalID string, source types.ExternalSource) *opts.FindBuilder {
+	return opts.NewFindBuilder(f.Client, fmt.Sprintf("/find/%s", url.PathEscape(externalID))).ExternalSource(source)
+}
@@ -0,0 +1,29 @@
+package endpoints_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/falconer001/gotmdb/tmdbtest"
+	"github.com/falconer001/gotmdb/types"
+)
+
+func TestFindByExternalID(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	tmdb := newTMDB(t, srv)
+
+	srv.Respond("GET /find/{id}", http.StatusOK, map[string]any{"movie_results": []map[string]any{{"id": 550, "title": "Fight Club"}}})
+	found, err := tmdb.Find.ByExternalID("tt0137523", types.ExternalSourceIMDb).Language("en-US").Exec()
+	if err != nil {
+		t.Fatalf("ByExternalID: %v", err)
+	}
+	if len(found.MovieResults) != 1 || found.MovieResults[0].ID != 550 {
+		t.Errorf("MovieResults = %+v, want Fight Club", found.MovieResults)
+	}
+
+	req := lastRequest(t, srv)
+	if req.Path != "/find/tt0137523" || req.Query.Get("external_source") != "imdb_id" || req.Query.Get("language") != "en-US" {
+		t.Errorf("sent %s?%s, want /find/tt0137523 with external_source=imdb_id and language=en-US", req.Path, req.Query.Encode())
+	}
+}
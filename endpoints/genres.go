@@ -0,0 +1,25 @@
+package endpoints
+
+import (
+	"github.com/falconer001/gotmdb/client"
+	opts "github.com/falconer001/gotmdb/options"
+	"github.com/falconer001/gotmdb/types"
+)
+
+// Genres handles communication with the genre related methods of the TMDb API.
+// See: https://developer.themoviedb.org/reference/genre-movie-list
+type Genres struct {
+	Client *client.Client
+}
+
+// GetMovieGenres retrieves the list of official movie genres.
+// See: https://developer.themoviedb.org/reference/genre-movie-list
+func (g *Genres) GetMovieGenres() *opts.LanguageBuilder[*types.GenreListResponse] {
+	return opts.NewLanguageBuilder[*types.GenreListResponse](g.Client, "/genre/movie/list")
+}
+
+// GetTVGenres retrieves the list of official TV genres.
+// See: https://developer.themoviedb.org/reference/genre-tv-list
+func (g *Genres) GetTVGenres() *opts.LanguageBuilder[*types.GenreListResponse] {
+	return opts.NewLanguageBuilder[*types.GenreListResponse](g.Client, "/genre/tv/list")
+}
@@ -0,0 +1,28 @@
+package endpoints
+
+import (
+	"fmt"
+
+	"github.com/falconer001/gotmdb/client"
+	opts "github.com/falconer001/gotmdb/options"
+	"github.com/falconer001/gotmdb/types"
+)
+
+// Keywords handles communication with the keyword related methods of the TMDb API.
+// See: https://developer.themoviedb.org/reference/keyword-details
+type Keywords struct {
+	Client *client.Client
+}
+
+// GetDetails retrieves the name of a keyword.
+// See: https://developer.themoviedb.org/reference/keyword-details
+func (k *Keywords) GetDetails(keywordID int) *opts.NoOptsBuilder[*types.Keyword] {
+	return opts.NewNoOptsBuilder[*types.Keyword](k.Client, fmt.Sprintf("/keyword/%d", keywordID))
+}
+
+// GetMovies retrieves the movies tagged with a keyword.
+// TMDb deprecated this endpoint in favor of discover; see Discover.DiscoverMovies and its WithKeywords option.
+// See: https://developer.themoviedb.org/reference/keyword-movies
+func (k *Keywords) GetMovies(keywordID int) *opts.PagedBuilder[*types.KeywordMoviesResponse] {
+	return opts.NewPagedBuilder[*types.KeywordMoviesResponse](k.Client, fmt.Sprintf("/keyword/%d/movies", keywordID))
+}
@@ -0,0 +1,58 @@
+package endpoints
+
+import (
+	"fmt"
+
+	"github.com/falconer001/gotmdb/client"
+	opts "github.com/falconer001/gotmdb/options"
+	"github.com/falconer001/gotmdb/types"
+)
+
+// Lists handles communication with the list related methods of the TMDb API.
+// Creating and editing lists requires a user session ID (set with .SessionID).
+// See: https://developer.themoviedb.org/reference/list-details
+type Lists struct {
+	Client *client.Client
+}
+
+// GetDetails retrieves the details of a list, along with a page of its items.
+// See: https://developer.themoviedb.org/reference/list-details
+func (l *Lists) GetDetails(listID int) *opts.PagedBuilder[*types.ListDetails] {
+	return opts.NewPagedBuilder[*types.ListDetails](l.Client, fmt.Sprintf("/list/%d", listID))
+}
+
+// Create creates a new list owned by the session's user.
+// See: https://developer.themoviedb.org/reference/list-create
+func (l *Lists) Create(body types.CreateListRequest) *opts.StateSessionBuilder[*types.CreateListResponse] {
+	return opts.NewStateSessionBuilder[*types.CreateListResponse](l.Client, "/list", "POST", body)
+}
+
+// AddItem adds a movie to a list.
+// See: https://developer.themoviedb.org/reference/list-add-movie
+func (l *Lists) AddItem(listID int, body types.AddItemRequest) *opts.StateSessionBuilder[*types.StatusResponse] {
+	return opts.NewStateSessionBuilder[*types.StatusResponse](l.Client, fmt.Sprintf("/list/%d/add_item", listID), "POST", body)
+}
+
+// RemoveItem removes a movie from a list.
+// See: https://developer.themoviedb.org/reference/list-remove-movie
+func (l *Lists) RemoveItem(listID int, body types.RemoveItemRequest) *opts.StateSessionBuilder[*types.StatusResponse] {
+	return opts.NewStateSessionBuilder[*types.StatusResponse](l.Client, fmt.Sprintf("/list/%d/remove_item", listID), "POST", body)
+}
+
+// GetItemStatus checks whether a movie is on a list.
+// See: https://developer.themoviedb.org/reference/list-check-item-status
+func (l *Lists) GetItemStatus(listID, movieID int) *opts.ListItemStatusBuilder {
+	return opts.NewListItemStatusBuilder(l.Client, fmt.Sprintf("/list/%d/item_status", listID)).MovieID(movieID)
+}
+
+// Clear removes every item from a list. The list itself is kept.
+// See: https://developer.themoviedb.org/reference/list-clear
+func (l *Lists) Clear(listID int) *opts.ListClearBuilder {
+	return opts.NewListClearBuilder(l.Client, fmt.Sprintf("/list/%d/clear", listID)).Confirm(true)
+}
+
+// Delete deletes a list.
+// See: https://developer.themoviedb.org/reference/list-delete
+func (l *Lists) Delete(listID int) *opts.StateSessionBuilder[*types.StatusResponse] {
+	return opts.NewStateSessionBuilder[*types.StatusResponse](l.Client, fmt.Sprintf("/list/%d", listID), "DELETE", nil)
+}
@@ -0,0 +1,64 @@
+package endpoints_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/falconer001/gotmdb"
+	"github.com/falconer001/gotmdb/tmdbtest"
+)
+
+func newTMDB(t *testing.T, srv *tmdbtest.Server) *gotmdb.TMDBClient {
+	t.Helper()
+	tmdb, err := gotmdb.New(srv.Config())
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	return tmdb
+}
+
+// lastRequest returns the last request received by srv.
+func lastRequest(t *testing.T, srv *tmdbtest.Server) tmdbtest.Request {
+	t.Helper()
+	reqs := srv.Requests()
+	if len(reqs) == 0 {
+		t.Fatal("no request was sent")
+	}
+	return reqs[len(reqs)-1]
+}
+
+func TestListsGetItemStatus(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	tmdb := newTMDB(t, srv)
+
+	srv.Respond("GET /list/{id}/item_status", http.StatusOK, map[string]any{"id": "8", "item_present": true})
+	status, err := tmdb.Lists.GetItemStatus(8, 550).Language("fr-FR").Exec()
+	if err != nil {
+		t.Fatalf("GetItemStatus: %v", err)
+	}
+	if !status.ItemPresent {
+		t.Error("ItemPresent = false, want true")
+	}
+
+	req := lastRequest(t, srv)
+	if req.Path != "/list/8/item_status" || req.Query.Get("movie_id") != "550" || req.Query.Get("language") != "fr-FR" {
+		t.Errorf("sent %s?%s, want /list/8/item_status with movie_id=550 and language=fr-FR", req.Path, req.Query.Encode())
+	}
+}
+
+func TestListsClear(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	tmdb := newTMDB(t, srv)
+
+	srv.Respond("POST /list/{id}/clear", http.StatusCreated, map[string]any{"status_code": 12, "status_message": "The item/record was updated successfully."})
+	if _, err := tmdb.Lists.Clear(8).SessionID("session").Exec(); err != nil {
+		t.Fatalf("Clear: %v", err)
+	}
+
+	req := lastRequest(t, srv)
+	if req.Method != http.MethodPost || req.Path != "/list/8/clear" || req.Query.Get("confirm") != "true" || req.Query.Get("session_id") != "session" {
+		t.Errorf("sent %s %s?%s, want POST /list/8/clear with confirm=true and the session", req.Method, req.Path, req.Query.Encode())
+	}
+}
@@ -0,0 +1,33 @@
+package endpoints
+
+import (
+	"fmt"
+
+	"github.com/falconer001/gotmdb/client"
+	opts "github.com/falconer001/gotmdb/options"
+	"github.com/falconer001/gotmdb/types"
+)
+
+// Networks handles communication with the TV network related methods of the TMDb API.
+// See: https://developer.themoviedb.org/reference/network-details
+type Networks struct {
+	Client *client.Client
+}
+
+// GetDetails retrieves the details of a TV network.
+// See: https://developer.themoviedb.org/reference/network-details
+func (n *Networks) GetDetails(networkID int) *opts.NoOptsBuilder[*types.Network] {
+	return opts.NewNoOptsBuilder[*types.Network](n.Client, fmt.Sprintf("/network/%d", networkID))
+}
+
+// GetAlternativeNames retrieves the alternative names of a TV network.
+// See: https://developer.themoviedb.org/reference/details-copy
+func (n *Networks) GetAlternativeNames(networkID int) *opts.NoOptsBuilder[*types.NetworkAlternativeNamesResponse] {
+	return opts.NewNoOptsBuilder[*types.NetworkAlternativeNamesResponse](n.Client, fmt.Sprintf("/network/%d/alternative_names", networkID))
+}
+
+// GetImages retrieves the logos of a TV network.
+// See: https://developer.themoviedb.org/reference/alternative-names-copy
+func (n *Networks) GetImages(networkID int) *opts.NoOptsBuilder[*types.NetworkImagesResponse] {
+	return opts.NewNoOptsBuilder[*types.NetworkImagesResponse](n.Client, fmt.Sprintf("/network/%d/images", networkID))
+}
@@ -0,0 +1,86 @@
+package endpoints
+
+import (
+	"fmt"
+
+	"github.com/falconer001/gotmdb/client"
+	opts "github.com/falconer001/gotmdb/options"
+	"github.com/falconer001/gotmdb/types"
+)
+
+// People handles communication with the person related methods of the TMDb API.
+// See: https://developer.themoviedb.org/reference/person-details
+type People struct {
+	Client *client.Client
+}
+
+// GetDetails retrieves the primary information about a person.
+// Supports appending additional data like movie_credits, tv_credits, combined_credits, images, external_ids etc., using AppendToResponse.
+// See: https://developer.themoviedb.org/reference/person-details
+func (p *People) GetDetails(personID int) *opts.AppendToResponseBuilder[*types.PersonDetails] {
+	return opts.NewAppendToResponseBuilder[*types.PersonDetails](p.Client, fmt.Sprintf("/person/%d", personID))
+}
+
+// GetMovieCredits retrieves the movie credits (cast and crew) of a person.
+// See: https://developer.themoviedb.org/reference/person-movie-credits
+func (p *People) GetMovieCredits(personID int) *opts.LangBuilder[*types.PersonMovieCreditsResponse] {
+	return opts.NewLangBuilder[*types.PersonMovieCreditsResponse](p.Client, fmt.Sprintf("/person/%d/movie_credits", personID))
+}
+
+// GetTVCredits retrieves the TV credits (cast and crew) of a person.
+// See: https://developer.themoviedb.org/reference/person-tv-credits
+func (p *People) GetTVCredits(personID int) *opts.LangBuilder[*types.PersonTVCreditsResponse] {
+	return opts.NewLangBuilder[*types.PersonTVCreditsResponse](p.Client, fmt.Sprintf("/person/%d/tv_credits", personID))
+}
+
+// GetCombinedCredits retrieves the movie and TV credits of a person in a single response.
+// Each credit has a media_type telling whether it's a movie or a TV show.
+// See: https://developer.themoviedb.org/reference/person-combined-credits
+func (p *People) GetCombinedCredits(personID int) *opts.LangBuilder[*types.PersonCombinedCreditsResponse] {
+	return opts.NewLangBuilder[*types.PersonCombinedCreditsResponse](p.Client, fmt.Sprintf("/person/%d/combined_credits", personID))
+}
+
+// GetExternalIDs retrieves the external IDs for a person (e.g., IMDb, Wikidata, Instagram).
+// See: https://developer.themoviedb.org/reference/person-external-ids
+func (p *People) GetExternalIDs(personID int) *opts.NoOptsBuilder[*types.PersonExternalIDs] {
+	return opts.NewNoOptsBuilder[*types.PersonExternalIDs](p.Client, fmt.Sprintf("/person/%d/external_ids", personID))
+}
+
+// GetImages retrieves the profile images that belong to a person.
+// See: https://developer.themoviedb.org/reference/person-images
+func (p *People) GetImages(personID int) *opts.NoOptsBuilder[*types.PersonImagesResponse] {
+	return opts.NewNoOptsBuilder[*types.PersonImagesResponse](p.Client, fmt.Sprintf("/person/%d/images", personID))
+}
+
+// GetTaggedImages retrieves the movie and TV images a person has been tagged in.
+// See: https://developer.themoviedb.org/reference/person-tagged-images
+func (p *People) GetTaggedImages(personID int) *opts.PagedBuilder[*types.TaggedImagePaginatedResponse] {
+	return opts.NewPagedBuilder[*types.TaggedImagePaginatedResponse](p.Client, fmt.Sprintf("/person/%d/tagged_images", personID))
+}
+
+// GetTranslations retrieves the translations of a person's biography.
+// See: https://developer.themoviedb.org/reference/person-translations
+func (p *People) GetTranslations(personID int) *opts.NoOptsBuilder[*types.PersonTranslationsResponse] {
+	return opts.NewNoOptsBuilder[*types.PersonTranslationsResponse](p.Client, fmt.Sprintf("/person/%d/translations", personID))
+}
+
+// GetChanges retrieves the changes for a person.
+// By default, only the last 24 hours of changes are returned.
+// You can query up to 14 days in a single query by using the start_date and end_date opts.
+// See: https://developer.themoviedb.org/reference/person-changes
+func (p *People) GetChanges(personID int) *opts.ChangesBuilder {
+	return opts.NewChangesBuilder(p.Client, fmt.Sprintf("/person/%d/changes", personID))
+}
+
+// GetPopular retrieves a list of people ordered by popularity.
+// This list updates daily.
+// See: https://developer.themoviedb.org/reference/person-popular-list
+func (p *People) GetPopular() *opts.PagedBuilder[*types.PersonPaginatedResults] {
+	return opts.NewPagedBuilder[*types.PersonPaginatedResults](p.Client, "/person/popular")
+}
+
+// GetLatest retrieves the most recently created person.
+// See: https://developer.themoviedb.org/reference/person-latest-id
+func (p *People) GetLatest() *opts.NoOptsBuilder[*types.PersonDetails] {
+	return opts.NewNoOptsBuilder[*types.PersonDetails](p.Client, "/person/latest")
+}
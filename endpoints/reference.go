@@ -0,0 +1,282 @@
+package endpoints
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/falconer001/gotmdb/client"
+	"github.com/falconer001/gotmdb/types"
+)
+
+// DefaultReferenceTTL is how long Reference keeps its data unless its TTL is set.
+const DefaultReferenceTTL = 24 * time.Hour
+
+// DefaultReferenceRetryDelay is how long Reference waits after a failed reload unless its RetryDelay is set.
+const DefaultReferenceRetryDelay = time.Minute
+
+// Reference serves TMDb's reference data (configuration, countries, languages, jobs, timezones,
+// genres and certifications), loading it on first use and keeping it for TTL:
+//
+//	ref, err := tmdb.Reference.Data(ctx)
+//	if err != nil { ... }
+//	name, ok := ref.GenreName(28) // "Action"
+//
+// It's safe for concurrent use.
+type Reference struct {
+	Client *client.Client
+
+	// TTL is how long the data is kept before being loaded again. Defaults to DefaultReferenceTTL.
+	TTL time.Duration
+
+	// RetryDelay is how long the previous data keeps being served after a failed reload
+	// before the load is tried again. Defaults to DefaultReferenceRetryDelay.
+	RetryDelay time.Duration
+
+	// Language is the language of the genre names and country native names, e.g. "fr-FR".
+	// Defaults to TMDb's default, English.
+	Language string
+
+	mu       sync.Mutex
+	data     *ReferenceData
+	loadedAt time.Time
+	retryAt  time.Time
+	loading  *referenceLoad
+}
+
+// referenceLoad is a load shared by the callers waiting for it.
+type referenceLoad struct {
+	done chan struct{}
+	data *ReferenceData
+	err  error
+}
+
+// ReferenceData is a snapshot of TMDb's reference data. It must not be modified.
+type ReferenceData struct {
+	Configuration       types.APIConfiguration
+	Countries           []types.Country
+	Languages           []types.LanguageConfig
+	Jobs                []types.JobDepartment
+	Timezones           []types.Timezone
+	PrimaryTranslations []string
+	MovieGenres         []types.Genre
+	TVGenres            []types.Genre
+	// MovieCertifications and TVCertifications are keyed by country code, e.g. "US".
+	MovieCertifications map[string][]types.Certification
+	TVCertifications    map[string][]types.Certification
+
+	genres    map[int]string
+	countries map[string]types.Country
+	languages map[string]types.LanguageConfig
+}
+
+// Data returns the reference data, loading it if it hasn't been yet or is older than TTL.
+// Concurrent calls share a single load, which isn't tied to any caller: a caller giving up only stops waiting.
+// If a reload fails, the previous data is returned and the load is tried again after RetryDelay.
+func (r *Reference) Data(ctx context.Context) (*ReferenceData, error) {
+	r.mu.Lock()
+	now := time.Now()
+	if r.data != nil && (now.Sub(r.loadedAt) < r.ttl() || now.Before(r.retryAt)) {
+		data := r.data
+		r.mu.Unlock()
+		return data, nil
+	}
+	stale := r.data
+	load := r.startLoad(ctx)
+	r.mu.Unlock()
+
+	data, err := load.wait(ctx)
+	if err != nil && stale != nil && ctx.Err() == nil {
+		return stale, nil
+	}
+	return data, err
+}
+
+// Refresh loads the reference data again, regardless of its age.
+// If a load is already in progress, its result is returned.
+func (r *Reference) Refresh(ctx context.Context) (*ReferenceData, error) {
+	r.mu.Lock()
+	load := r.startLoad(ctx)
+	r.mu.Unlock()
+	return load.wait(ctx)
+}
+
+// startLoad starts loading the data, unless a load is in progress, and returns the load. r.mu must be held.
+// Only the HTTP client's timeout applies to the load, not the deadline of ctx.
+func (r *Reference) startLoad(ctx context.Context) *referenceLoad {
+	if r.loading != nil {
+		return r.loading
+	}
+	load := &referenceLoad{done: make(chan struct{})}
+	r.loading = load
+
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		data, err := r.load(ctx)
+
+		r.mu.Lock()
+		if err != nil {
+			r.retryAt = time.Now().Add(r.retryDelay())
+		} else {
+			r.data, r.loadedAt, r.retryAt = data, time.Now(), time.Time{}
+		}
+		r.loading = nil
+		r.mu.Unlock()
+
+		load.data, load.err = data, err
+		close(load.done)
+	}()
+	return load
+}
+
+// wait returns the result of the load, or ctx's error if ctx is done first.
+func (l *referenceLoad) wait(ctx context.Context) (*ReferenceData, error) {
+	select {
+	case <-l.done:
+		return l.data, l.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (r *Reference) ttl() time.Duration {
+	if r.TTL <= 0 {
+		return DefaultReferenceTTL
+	}
+	return r.TTL
+}
+
+func (r *Reference) retryDelay() time.Duration {
+	if r.RetryDelay <= 0 {
+		return DefaultReferenceRetryDelay
+	}
+	return r.RetryDelay
+}
+
+func (r *Reference) load(ctx context.Context) (*ReferenceData, error) {
+	var (
+		data = new(ReferenceData)
+		err  error
+	)
+	configuration := &Configuration{Client: r.Client}
+	genres := &Genres{Client: r.Client}
+	certifications := &Certifications{Client: r.Client}
+
+	details, err := configuration.GetDetails().ExecContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	data.Configuration = *details
+
+	countries := configuration.GetCountries()
+	movieGenres, tvGenres := genres.GetMovieGenres(), genres.GetTVGenres()
+	if r.Language != "" {
+		countries.Language(r.Language)
+		movieGenres.Language(r.Language)
+		tvGenres.Language(r.Language)
+	}
+	if data.Countries, err = countries.ExecContext(ctx); err != nil {
+		return nil, err
+	}
+	if data.Languages, err = configuration.GetLanguages().ExecContext(ctx); err != nil {
+		return nil, err
+	}
+	if data.Jobs, err = configuration.GetJobs().ExecContext(ctx); err != nil {
+		return nil, err
+	}
+	if data.Timezones, err = configuration.GetTimezones().ExecContext(ctx); err != nil {
+		return nil, err
+	}
+	if data.PrimaryTranslations, err = configuration.GetPrimaryTranslations().ExecContext(ctx); err != nil {
+		return nil, err
+	}
+
+	movie, err := movieGenres.ExecContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tv, err := tvGenres.ExecContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	data.MovieGenres, data.TVGenres = movie.Genres, tv.Genres
+
+	movieCerts, err := certifications.GetMovieCertifications().ExecContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tvCerts, err := certifications.GetTVCertifications().ExecContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	data.MovieCertifications, data.TVCertifications = movieCerts.Certifications, tvCerts.Certifications
+
+	data.index()
+	return data, nil
+}
+
+// index builds the lookup maps.
+func (d *ReferenceData) index() {
+	d.genres = make(map[int]string, len(d.MovieGenres)+len(d.TVGenres))
+	for _, g := range d.MovieGenres {
+		d.genres[g.ID] = g.Name
+	}
+	for _, g := range d.TVGenres {
+		d.genres[g.ID] = g.Name
+	}
+	d.countries = make(map[string]types.Country, len(d.Countries))
+	for _, c := range d.Countries {
+		d.countries[strings.ToUpper(c.ISO3166_1)] = c
+	}
+	d.languages = make(map[string]types.LanguageConfig, len(d.Languages))
+	for _, l := range d.Languages {
+		d.languages[strings.ToLower(l.ISO639_1)] = l
+	}
+}
+
+// GenreName returns the name of a movie or TV genre.
+func (d *ReferenceData) GenreName(id int) (string, bool) {
+	name, ok := d.genres[id]
+	return name, ok
+}
+
+// GenreNames returns the names of the genres in ids, e.g. the GenreIDs of a list result.
+// Unknown IDs are skipped.
+func (d *ReferenceData) GenreNames(ids []int) []string {
+	names := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if name, ok := d.genres[id]; ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// Country returns the country with an ISO 3166-1 code, e.g. "US". The code is case insensitive.
+func (d *ReferenceData) Country(iso3166_1 string) (types.Country, bool) {
+	c, ok := d.countries[strings.ToUpper(iso3166_1)]
+	return c, ok
+}
+
+// Language returns the language with an ISO 639-1 code, e.g. "en". The code is case insensitive.
+func (d *ReferenceData) Language(iso639_1 string) (types.LanguageConfig, bool) {
+	l, ok := d.languages[strings.ToLower(iso639_1)]
+	return l, ok
+}
+
+// DepartmentJobs returns the jobs of a department, e.g. "Directing".
+func (d *ReferenceData) DepartmentJobs(department string) ([]string, bool) {
+	for _, dep := range d.Jobs {
+		if strings.EqualFold(dep.Department, department) {
+			return dep.Jobs, true
+		}
+	}
+	return nil, false
+}
+
+// ImageURL returns the full URL of an image from its file path and a size such as "w500" or "original".
+// The size isn't checked against the available sizes of the configuration.
+func (d *ReferenceData) ImageURL(filePath, size string) string {
+	return strings.TrimSuffix(d.Configuration.Images.SecureBaseURL, "/") + "/" + size + "/" + strings.TrimPrefix(filePath, "/")
+}
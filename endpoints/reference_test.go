@@ -0,0 +1,136 @@
+package endpoints_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/falconer001/gotmdb/endpoints"
+	"github.com/falconer001/gotmdb/tmdbtest"
+)
+
+// serveReference makes srv answer the requests loading the reference data.
+func serveReference(srv *tmdbtest.Server) {
+	srv.Respond("GET /configuration", http.StatusOK, map[string]any{
+		"images": map[string]any{"secure_base_url": "https://image.tmdb.org/t/p/"},
+	})
+	srv.Respond("GET /configuration/countries", http.StatusOK, []map[string]any{{"iso_3166_1": "US", "english_name": "United States of America"}})
+	srv.Respond("GET /configuration/languages", http.StatusOK, []map[string]any{{"iso_639_1": "en", "english_name": "English"}})
+	srv.Respond("GET /configuration/jobs", http.StatusOK, []map[string]any{{"department": "Directing", "jobs": []string{"Director"}}})
+	srv.Respond("GET /configuration/timezones", http.StatusOK, []map[string]any{})
+	srv.Respond("GET /configuration/primary_translations", http.StatusOK, []string{"en-US"})
+	srv.Respond("GET /genre/movie/list", http.StatusOK, map[string]any{"genres": []map[string]any{{"id": 28, "name": "Action"}}})
+	srv.Respond("GET /genre/tv/list", http.StatusOK, map[string]any{"genres": []map[string]any{{"id": 10759, "name": "Action & Adventure"}}})
+	srv.Respond("GET /certification/movie/list", http.StatusOK, map[string]any{"certifications": map[string]any{}})
+	srv.Respond("GET /certification/tv/list", http.StatusOK, map[string]any{"certifications": map[string]any{}})
+}
+
+// loads counts the loads of the reference data, each starting with GET /configuration.
+func loads(srv *tmdbtest.Server) int {
+	n := 0
+	for _, r := range srv.Requests() {
+		if r.Path == "/configuration" {
+			n++
+		}
+	}
+	return n
+}
+
+func TestReferenceData(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	serveReference(srv)
+	tmdb := newTMDB(t, srv)
+
+	ref, err := tmdb.Reference.Data(context.Background())
+	if err != nil {
+		t.Fatalf("Data: %v", err)
+	}
+	if name, _ := ref.GenreName(10759); name != "Action & Adventure" {
+		t.Errorf("GenreName(10759) = %q", name)
+	}
+	if c, ok := ref.Country("us"); !ok || c.EnglishName != "United States of America" {
+		t.Errorf("Country(us) = %+v, %t", c, ok)
+	}
+	if url := ref.ImageURL("/poster.jpg", "w500"); url != "https://image.tmdb.org/t/p/w500/poster.jpg" {
+		t.Errorf("ImageURL = %q", url)
+	}
+
+	// Kept until the TTL is over
+	if _, err := tmdb.Reference.Data(context.Background()); err != nil {
+		t.Fatalf("second Data: %v", err)
+	}
+	if n := loads(srv); n != 1 {
+		t.Errorf("loaded %d times, want 1", n)
+	}
+}
+
+func TestReferenceSharedLoad(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	serveReference(srv)
+	srv.SetLatency("GET /configuration", 100*time.Millisecond)
+	tmdb := newTMDB(t, srv)
+
+	// The caller starting the load gives up, the others still get the data
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := tmdb.Reference.Data(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want context.DeadlineExceeded", err)
+	}
+
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := tmdb.Reference.Data(context.Background()); err != nil {
+				t.Errorf("Data: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if n := loads(srv); n != 1 {
+		t.Errorf("loaded %d times, want 1", n)
+	}
+}
+
+func TestReferenceStaleData(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	serveReference(srv)
+	tmdb := newTMDB(t, srv)
+	ref := &endpoints.Reference{Client: tmdb.Reference.Client, TTL: time.Millisecond, RetryDelay: 100 * time.Millisecond}
+	ctx := context.Background()
+
+	first, err := ref.Data(ctx)
+	if err != nil {
+		t.Fatalf("Data: %v", err)
+	}
+
+	// The reload fails: the previous data is served without trying again until RetryDelay is over
+	srv.Fail("GET /configuration/countries", http.StatusServiceUnavailable, 9, "Service offline: This service is temporarily offline, try again later.")
+	time.Sleep(5 * time.Millisecond)
+	for range 3 {
+		data, err := ref.Data(ctx)
+		if err != nil || data != first {
+			t.Fatalf("Data = %p, %v, want the previous data", data, err)
+		}
+	}
+	if n := loads(srv); n != 2 {
+		t.Errorf("loaded %d times, want 2", n)
+	}
+	if _, err := ref.Refresh(ctx); err == nil {
+		t.Error("Refresh succeeded, want the load's error")
+	}
+
+	time.Sleep(120 * time.Millisecond)
+	serveReference(srv)
+	data, err := ref.Data(ctx)
+	if err != nil || data == first {
+		t.Errorf("Data = %p, %v, want new data after RetryDelay", data, err)
+	}
+}
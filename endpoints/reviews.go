@@ -0,0 +1,23 @@
+package endpoints
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/falconer001/gotmdb/client"
+	opts "github.com/falconer001/gotmdb/options"
+	"github.com/falconer001/gotmdb/types"
+)
+
+// Reviews handles communication with the review related methods of the TMDb API.
+// Reviews of a movie or TV series are listed by Movies.GetReviews and TV.GetReviews.
+// See: https://developer.themoviedb.org/reference/review-details
+type Reviews struct {
+	Client *client.Client
+}
+
+// GetDetails retrieves a review along with the movie or TV show it's about.
+// See: https://developer.themoviedb.org/reference/review-details
+func (r *Reviews) GetDetails(reviewID string) *opts.NoOptsBuilder[*types.ReviewDetails] {
+	return opts.NewNoOptsBuilder[*types.ReviewDetails](r.Client, fmt.Sprintf("/review/%s", url.PathEscape(reviewID)))
+}
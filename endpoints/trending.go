@@ -0,0 +1,40 @@
+package endpoints
+
+import (
+	"fmt"
+
+	"github.com/falconer001/gotmdb/client"
+	opts "github.com/falconer001/gotmdb/options"
+	"github.com/falconer001/gotmdb/types"
+)
+
+// Trending handles communication with the trending related methods of the TMDb API.
+// See: https://developer.themoviedb.org/reference/trending-all
+type Trending struct {
+	Client *client.Client
+}
+
+// Get retrieves the trending movies, TV shows and/or people of the day or the week.
+// Results of TrendingAll are told apart by their MediaType.
+// See: https://developer.themoviedb.org/reference/trending-all
+func (t *Trending) Get(mediaType types.TrendingMediaType, window types.TimeWindow) *opts.PagedBuilder[*types.TrendingResponse] {
+	return opts.NewPagedBuilder[*types.TrendingResponse](t.Client, fmt.Sprintf("/trending/%s/%s", mediaType, window))
+}
+
+// GetMovies retrieves the trending movies of the day or the week.
+// See: https://developer.themoviedb.org/reference/trending-movies
+func (t *Trending) GetMovies(window types.TimeWindow) *opts.PagedBuilder[*types.TrendingResponse] {
+	return t.Get(types.TrendingMovie, window)
+}
+
+// GetTV retrieves the trending TV shows of the day or the week.
+// See: https://developer.themoviedb.org/reference/trending-tv
+func (t *Trending) GetTV(window types.TimeWindow) *opts.PagedBuilder[*types.TrendingResponse] {
+	return t.Get(types.TrendingTV, window)
+}
+
+// GetPeople retrieves the trending people of the day or the week.
+// See: https://developer.themoviedb.org/reference/trending-people
+func (t *Trending) GetPeople(window types.TimeWindow) *opts.PagedBuilder[*types.TrendingResponse] {
+	return t.Get(types.TrendingPerson, window)
+}
@@ -0,0 +1,49 @@
+package endpoints
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/falconer001/gotmdb/options"
+	"github.com/falconer001/gotmdb/types"
+)
+
+// ErrNoEpisodeGroup is returned by GetEpisodesInGroupOrder when a series has no episode group of the requested type.
+var ErrNoEpisodeGroup = errors.New("tmdb: no episode group of this type")
+
+// GetEpisodeGroup retrieves the details of an episode group: its subgroups and their episodes.
+// Group IDs are listed by GetEpisodeGroups.
+// See: https://developer.themoviedb.org/reference/tv-episode-group-details
+func (t *TV) GetEpisodeGroup(groupID string) *options.NoOptsBuilder[*types.EpisodeGroupDetails] {
+	return options.NewNoOptsBuilder[*types.EpisodeGroupDetails](t.Client, fmt.Sprintf("/tv/episode_group/%s", url.PathEscape(groupID)))
+}
+
+// GetEpisodesInGroupOrder returns the episodes of a series in the order of one of its episode groups,
+// e.g. types.EpisodeGroupAbsolute for the absolute numbering of anime or types.EpisodeGroupDVD for the DVD order.
+// When the series has several groups of that type, the one with the most episodes is used.
+// It returns ErrNoEpisodeGroup if the series has none.
+// See EpisodeGroupDetails.OrderedEpisodes to reorder episodes with a group you picked yourself.
+func (t *TV) GetEpisodesInGroupOrder(ctx context.Context, seriesID int, groupType types.EpisodeGroupType) ([]types.OrderedEpisode, error) {
+	groups, err := t.GetEpisodeGroups(seriesID).ExecContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var chosen *types.EpisodeGroup
+	for i, group := range groups.Results {
+		if group.Type == groupType && (chosen == nil || group.EpisodeCount > chosen.EpisodeCount) {
+			chosen = &groups.Results[i]
+		}
+	}
+	if chosen == nil {
+		return nil, fmt.Errorf("%w: series %d, type %d", ErrNoEpisodeGroup, seriesID, groupType)
+	}
+
+	details, err := t.GetEpisodeGroup(chosen.ID).ExecContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return details.OrderedEpisodes(), nil
+}
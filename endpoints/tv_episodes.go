@@ -0,0 +1,94 @@
+package endpoints
+
+import (
+	"fmt"
+
+	"github.com/falconer001/gotmdb/client"
+	"github.com/falconer001/gotmdb/options"
+	"github.com/falconer001/gotmdb/types"
+)
+
+// TVEpisode handles communication with the methods of a TV episode. Get one with TV.Episode.
+// See: https://developer.themoviedb.org/reference/tv-episode-details
+type TVEpisode struct {
+	Client        *client.Client
+	SeriesID      int
+	SeasonNumber  int
+	EpisodeNumber int
+}
+
+// Episode returns the methods of an episode of a TV series, e.g. tmdb.TV.Episode(1399, 1, 1).GetDetails().
+func (t *TV) Episode(seriesID, seasonNumber, episodeNumber int) *TVEpisode {
+	return &TVEpisode{Client: t.Client, SeriesID: seriesID, SeasonNumber: seasonNumber, EpisodeNumber: episodeNumber}
+}
+
+// GetEpisodeChanges retrieves the changes for a TV episode.
+// Unlike the other episode methods, it takes the episode's own ID (TVEpisodeDetails.ID).
+// By default, only the last 24 hours of changes are returned.
+// You can query up to 14 days in a single query by using the start_date and end_date options.
+// See: https://developer.themoviedb.org/reference/tv-episode-changes-by-id
+func (t *TV) GetEpisodeChanges(episodeID int) *options.ChangesBuilder {
+	return options.NewChangesBuilder(t.Client, fmt.Sprintf("/tv/episode/%d/changes", episodeID))
+}
+
+func (e *TVEpisode) path(suffix string) string {
+	return fmt.Sprintf("/tv/%d/season/%d/episode/%d%s", e.SeriesID, e.SeasonNumber, e.EpisodeNumber, suffix)
+}
+
+// GetDetails retrieves the primary information about a TV episode.
+// Supports appending additional data like credits, images, videos, external_ids etc., using AppendToResponse.
+// See: https://developer.themoviedb.org/reference/tv-episode-details
+func (e *TVEpisode) GetDetails() *options.AppendToResponseBuilder[*types.TVEpisodeDetails] {
+	return options.NewAppendToResponseBuilder[*types.TVEpisodeDetails](e.Client, e.path(""))
+}
+
+// GetAccountStates retrieves the rating status of a TV episode for a specific account.
+// Requires either a SessionID or GuestSessionID.
+// See: https://developer.themoviedb.org/reference/tv-episode-account-states
+func (e *TVEpisode) GetAccountStates() *options.StateSessionBuilder[*types.EpisodeAccountStateSingle] {
+	return options.NewStateSessionBuilder[*types.EpisodeAccountStateSingle](e.Client, e.path("/account_states"), "GET", nil)
+}
+
+// Rate rates a TV episode.
+// A valid session or guest session ID is required.
+// See: https://developer.themoviedb.org/reference/tv-episode-add-rating
+func (e *TVEpisode) Rate(body types.RatingRequest) *options.StateSessionBuilder[*types.StatusResponse] {
+	return options.NewStateSessionBuilder[*types.StatusResponse](e.Client, e.path("/rating"), "POST", body)
+}
+
+// DeleteRating removes your rating for a TV episode.
+// A valid session or guest session ID is required.
+// See: https://developer.themoviedb.org/reference/tv-episode-delete-rating
+func (e *TVEpisode) DeleteRating() *options.StateSessionBuilder[*types.StatusResponse] {
+	return options.NewStateSessionBuilder[*types.StatusResponse](e.Client, e.path("/rating"), "DELETE", nil)
+}
+
+// GetCredits retrieves the cast, crew and guest stars of a TV episode.
+// See: https://developer.themoviedb.org/reference/tv-episode-credits
+func (e *TVEpisode) GetCredits() *options.LangBuilder[*types.TVEpisodeCreditsResponse] {
+	return options.NewLangBuilder[*types.TVEpisodeCreditsResponse](e.Client, e.path("/credits"))
+}
+
+// GetExternalIDs retrieves the external IDs for a TV episode (e.g., IMDb ID, TVDB ID).
+// See: https://developer.themoviedb.org/reference/tv-episode-external-ids
+func (e *TVEpisode) GetExternalIDs() *options.NoOptsBuilder[*types.TVEpisodeExternalIDs] {
+	return options.NewNoOptsBuilder[*types.TVEpisodeExternalIDs](e.Client, e.path("/external_ids"))
+}
+
+// GetImages retrieves the stills that belong to a TV episode.
+// See: https://developer.themoviedb.org/reference/tv-episode-images
+func (e *TVEpisode) GetImages() *options.LangBuilder[*types.TVEpisodeImagesResponse] {
+	return options.NewLangBuilder[*types.TVEpisodeImagesResponse](e.Client, e.path("/images"))
+}
+
+// GetTranslations retrieves the translations that have been created for a TV episode.
+// See: https://developer.themoviedb.org/reference/tv-episode-translations
+func (e *TVEpisode) GetTranslations() *options.NoOptsBuilder[*types.TVEpisodeTranslationsResponse] {
+	return options.NewNoOptsBuilder[*types.TVEpisodeTranslationsResponse](e.Client, e.path("/translations"))
+}
+
+// GetVideos retrieves the videos that have been added to a TV episode.
+// See: https://developer.themoviedb.org/reference/tv-episode-videos
+func (e *TVEpisode) GetVideos() *options.LangBuilder[*types.TVEpisodeVideosResponse] {
+	return options.NewLangBuilder[*types.TVEpisodeVideosResponse](e.Client, e.path("/videos"))
+}
@@ -0,0 +1,93 @@
+package endpoints
+
+import (
+	"fmt"
+
+	"github.com/falconer001/gotmdb/client"
+	"github.com/falconer001/gotmdb/options"
+	"github.com/falconer001/gotmdb/types"
+)
+
+// TVSeason handles communication with the methods of a TV season. Get one with TV.Season.
+// See: https://developer.themoviedb.org/reference/tv-season-details
+type TVSeason struct {
+	Client       *client.Client
+	SeriesID     int
+	SeasonNumber int
+}
+
+// Season returns the methods of season seasonNumber of a TV series, e.g. tmdb.TV.Season(1399, 1).GetDetails().
+// Season 0 holds the specials.
+func (t *TV) Season(seriesID, seasonNumber int) *TVSeason {
+	return &TVSeason{Client: t.Client, SeriesID: seriesID, SeasonNumber: seasonNumber}
+}
+
+// GetSeasonChanges retrieves the changes for a TV season.
+// Unlike the other season methods, it takes the season's own ID (TVSeasonDetails.ID).
+// By default, only the last 24 hours of changes are returned.
+// You can query up to 14 days in a single query by using the start_date and end_date options.
+// See: https://developer.themoviedb.org/reference/tv-season-changes-by-id
+func (t *TV) GetSeasonChanges(seasonID int) *options.ChangesBuilder {
+	return options.NewChangesBuilder(t.Client, fmt.Sprintf("/tv/season/%d/changes", seasonID))
+}
+
+func (s *TVSeason) path(suffix string) string {
+	return fmt.Sprintf("/tv/%d/season/%d%s", s.SeriesID, s.SeasonNumber, suffix)
+}
+
+// GetDetails retrieves the primary information about a TV season, along with its episodes.
+// Supports appending additional data like credits, images, videos, external_ids etc., using AppendToResponse.
+// See: https://developer.themoviedb.org/reference/tv-season-details
+func (s *TVSeason) GetDetails() *options.AppendToResponseBuilder[*types.TVSeasonDetails] {
+	return options.NewAppendToResponseBuilder[*types.TVSeasonDetails](s.Client, s.path(""))
+}
+
+// GetAccountStates retrieves the rating status of the season's episodes for a specific account.
+// Requires either a SessionID or GuestSessionID.
+// See: https://developer.themoviedb.org/reference/tv-season-account-states
+func (s *TVSeason) GetAccountStates() *options.StateSessionBuilder[*types.TVSeasonAccountStatesResponse] {
+	return options.NewStateSessionBuilder[*types.TVSeasonAccountStatesResponse](s.Client, s.path("/account_states"), "GET", nil)
+}
+
+// GetAggregateCredits retrieves the cast and crew of the whole season.
+// See: https://developer.themoviedb.org/reference/tv-season-aggregate-credits
+func (s *TVSeason) GetAggregateCredits() *options.LangBuilder[*types.AggregateCreditsResponse] {
+	return options.NewLangBuilder[*types.AggregateCreditsResponse](s.Client, s.path("/aggregate_credits"))
+}
+
+// GetCredits retrieves the cast and crew of the season's latest episode.
+// See: https://developer.themoviedb.org/reference/tv-season-credits
+func (s *TVSeason) GetCredits() *options.LangBuilder[*types.Credits] {
+	return options.NewLangBuilder[*types.Credits](s.Client, s.path("/credits"))
+}
+
+// GetExternalIDs retrieves the external IDs for a TV season (e.g., TVDB ID).
+// See: https://developer.themoviedb.org/reference/tv-season-external-ids
+func (s *TVSeason) GetExternalIDs() *options.NoOptsBuilder[*types.TVSeasonExternalIDs] {
+	return options.NewNoOptsBuilder[*types.TVSeasonExternalIDs](s.Client, s.path("/external_ids"))
+}
+
+// GetImages retrieves the posters that belong to a TV season.
+// See: https://developer.themoviedb.org/reference/tv-season-images
+func (s *TVSeason) GetImages() *options.LangBuilder[*types.TVSeasonImagesResponse] {
+	return options.NewLangBuilder[*types.TVSeasonImagesResponse](s.Client, s.path("/images"))
+}
+
+// GetTranslations retrieves the translations that have been created for a TV season.
+// See: https://developer.themoviedb.org/reference/tv-season-translations
+func (s *TVSeason) GetTranslations() *options.NoOptsBuilder[*types.TVSeasonTranslationsResponse] {
+	return options.NewNoOptsBuilder[*types.TVSeasonTranslationsResponse](s.Client, s.path("/translations"))
+}
+
+// GetVideos retrieves the videos that have been added to a TV season.
+// See: https://developer.themoviedb.org/reference/tv-season-videos
+func (s *TVSeason) GetVideos() *options.LangBuilder[*types.TVSeasonVideosResponse] {
+	return options.NewLangBuilder[*types.TVSeasonVideosResponse](s.Client, s.path("/videos"))
+}
+
+// GetWatchProviders retrieves the watch providers of a TV season.
+// Powered by JustWatch.
+// See: https://developer.themoviedb.org/reference/tv-season-watch-providers
+func (s *TVSeason) GetWatchProviders() *options.LangBuilder[*types.WatchProviderResponse] {
+	return options.NewLangBuilder[*types.WatchProviderResponse](s.Client, s.path("/watch/providers"))
+}
@@ -0,0 +1,34 @@
+package endpoints
+
+import (
+	"github.com/falconer001/gotmdb/client"
+	opts "github.com/falconer001/gotmdb/options"
+	"github.com/falconer001/gotmdb/types"
+)
+
+// WatchProviders handles communication with the watch provider catalog of the TMDb API.
+// The providers of a title are returned by Movies.GetWatchProviders and TV.GetWatchProviders;
+// see types.WatchProviderResponse.Availability to query them by region.
+// Powered by JustWatch.
+// See: https://developer.themoviedb.org/reference/watch-providers-available-regions
+type WatchProviders struct {
+	Client *client.Client
+}
+
+// GetRegions retrieves the regions that have watch provider data.
+// See: https://developer.themoviedb.org/reference/watch-providers-available-regions
+func (w *WatchProviders) GetRegions() *opts.LanguageBuilder[*types.WatchProviderRegionsResponse] {
+	return opts.NewLanguageBuilder[*types.WatchProviderRegionsResponse](w.Client, "/watch/providers/regions")
+}
+
+// GetMovieProviders retrieves the providers offering movies, optionally limited to a region with WatchRegion.
+// See: https://developer.themoviedb.org/reference/watch-providers-movie-list
+func (w *WatchProviders) GetMovieProviders() *opts.WatchProviderListBuilder {
+	return opts.NewWatchProviderListBuilder(w.Client, "/watch/providers/movie")
+}
+
+// GetTVProviders retrieves the providers offering TV shows, optionally limited to a region with WatchRegion.
+// See: https://developer.themoviedb.org/reference/watch-provider-tv-list
+func (w *WatchProviders) GetTVProviders() *opts.WatchProviderListBuilder {
+	return opts.NewWatchProviderListBuilder(w.Client, "/watch/providers/tv")
+}
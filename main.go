@@ -12,6 +12,29 @@ type TMDBClient struct {
 	Search   *endpoints.Search
 	Movies   *endpoints.Movies
 	Discover *endpoints.Discover
+	People   *endpoints.People
+	Account  *endpoints.Account
+	Lists    *endpoints.Lists
+
+	Collections *endpoints.Collections
+	Companies   *endpoints.Companies
+	Networks    *endpoints.Networks
+
+	Configuration  *endpoints.Configuration
+	Certifications *endpoints.Certifications
+	Genres         *endpoints.Genres
+
+	Trending *endpoints.Trending
+	Find     *endpoints.Find
+	Keywords *endpoints.Keywords
+	Reviews  *endpoints.Reviews
+	Credits  *endpoints.Credits
+
+	WatchProviders *endpoints.WatchProviders
+	Changes        *endpoints.Changes
+
+	// Reference caches the configuration, genres and certifications for lookups. See endpoints.Reference.
+	Reference *endpoints.Reference
 }
 
 func New(config Config) (*TMDBClient, error) {
@@ -27,6 +50,28 @@ func New(config Config) (*TMDBClient, error) {
 		Search:   &endpoints.Search{Client: c},
 		Movies:   &endpoints.Movies{Client: c},
 		Discover: &endpoints.Discover{Client: c},
+		People:   &endpoints.People{Client: c},
+		Account:  &endpoints.Account{Client: c},
+		Lists:    &endpoints.Lists{Client: c},
+
+		Collections: &endpoints.Collections{Client: c},
+		Companies:   &endpoints.Companies{Client: c},
+		Networks:    &endpoints.Networks{Client: c},
+
+		Configuration:  &endpoints.Configuration{Client: c},
+		Certifications: &endpoints.Certifications{Client: c},
+		Genres:         &endpoints.Genres{Client: c},
+
+		Trending: &endpoints.Trending{Client: c},
+		Find:     &endpoints.Find{Client: c},
+		Keywords: &endpoints.Keywords{Client: c},
+		Reviews:  &endpoints.Reviews{Client: c},
+		Credits:  &endpoints.Credits{Client: c},
+
+		WatchProviders: &endpoints.WatchProviders{Client: c},
+		Changes:        &endpoints.Changes{Client: c},
+
+		Reference: &endpoints.Reference{Client: c},
 	}
 
 	return tc, nil
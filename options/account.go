@@ -0,0 +1,86 @@
+package options
+
+import (
+	"context"
+
+	"github.com/falconer001/gotmdb/client"
+	"github.com/falconer001/gotmdb/types"
+)
+
+// *ACCOUNT LIST BUILDER
+// AccountListBuilder For the paginated /account lists: favorites, watchlist, rated items and lists.
+// Without a session ID, the client's bearer token is used.
+type AccountListBuilder[T allowedAccountListT] struct {
+	client *client.Client
+	path   string
+	opts   struct {
+		Language  *string `url:"language,omitempty"`
+		Page      *int    `url:"page,omitempty"`
+		SortBy    *string `url:"sort_by,omitempty"`
+		SessionID *string `url:"session_id,omitempty"`
+	}
+}
+
+type allowedAccountListT interface {
+	*types.ListPaginatedResults |
+		*types.MoviePaginatedResults |
+		*types.TVShowPaginatedResults |
+		*types.RatedMoviePaginatedResults |
+		*types.RatedTVShowPaginatedResults |
+		*types.RatedTVEpisodePaginatedResults
+}
+
+func NewAccountListBuilder[T allowedAccountListT](c *client.Client, path string) *AccountListBuilder[T] {
+	return &AccountListBuilder[T]{
+		client: c,
+		path:   path,
+	}
+}
+
+// Language sets the language parameter. e.g. "en-US", "fr-FR"
+func (b *AccountListBuilder[T]) Language(lang string) *AccountListBuilder[T] {
+	b.opts.Language = &lang
+	return b
+}
+
+// Page sets the page parameter. e.g. 1, 2, 3, etc.
+func (b *AccountListBuilder[T]) Page(p int) *AccountListBuilder[T] {
+	b.opts.Page = &p
+	return b
+}
+
+// SortBy sets the sort_by parameter. e.g. "created_at.asc", "created_at.desc". Defaults to created_at.asc
+// Not supported by the account's lists.
+func (b *AccountListBuilder[T]) SortBy(sort string) *AccountListBuilder[T] {
+	b.opts.SortBy = &sort
+	return b
+}
+
+// SessionID sets the session ID parameter.
+// If not set, the client's bearer token is used.
+// See: https://developer.themoviedb.org/reference/authentication-create-session
+func (b *AccountListBuilder[T]) SessionID(id string) *AccountListBuilder[T] {
+	b.opts.SessionID = &id
+	return b
+}
+
+// Exec performs the request and returns the response.
+func (b *AccountListBuilder[T]) Exec() (T, error) {
+	return b.ExecContext(context.Background())
+}
+
+// ExecContext is like Exec but uses ctx for the request.
+func (b *AccountListBuilder[T]) ExecContext(ctx context.Context) (T, error) {
+	return b.execPage(ctx, b.opts.Page)
+}
+
+func (b *AccountListBuilder[T]) startPage() int {
+	return pageOrFirst(b.opts.Page)
+}
+
+// execPage performs the request for page, or without a page parameter if nil.
+func (b *AccountListBuilder[T]) execPage(ctx context.Context, page *int) (T, error) {
+	opts := b.opts
+	opts.Page = page
+	return sendPrivate[T](ctx, b.client, "GET", b.path, nil, opts)
+}
@@ -1,6 +1,8 @@
 package options
 
 import (
+	"context"
+
 	"github.com/falconer001/gotmdb/client"
 	"github.com/falconer001/gotmdb/types"
 )
@@ -30,15 +32,18 @@ func NewAuthBuilder[T AuthResponse](client *client.Client, path string, method s
 
 // Exec performs the request and returns the response.
 func (n *AuthBuilder[T]) Exec() (*T, error) {
-	resp := new(T)
+	return n.ExecContext(context.Background())
+}
 
+// ExecContext is like Exec but uses ctx for the request.
+func (n *AuthBuilder[T]) ExecContext(ctx context.Context) (*T, error) {
 	if n.method == "GET" {
 		n.body = nil
 	}
 
-	err := n.client.DoRequest(n.method, n.path, nil, n.body, resp)
+	resp, err := sendPrivate[T](ctx, n.client, n.method, n.path, n.body)
 	if err != nil {
 		return nil, err
 	}
-	return resp, nil
+	return &resp, nil
 }
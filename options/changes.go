@@ -0,0 +1,80 @@
+package options
+
+import (
+	"context"
+	"iter"
+
+	"github.com/falconer001/gotmdb/client"
+	"github.com/falconer001/gotmdb/types"
+)
+
+// *CHANGE LIST BUILDER
+// ChangeListBuilder For the global /movie/changes, /tv/changes and /person/changes endpoints
+// Dates must be YYYY-MM-DD, and at most 14 days apart
+type ChangeListBuilder struct {
+	request[*ChangeListBuilder]
+	opts struct {
+		StartDate *string `url:"start_date,omitempty"`
+		EndDate   *string `url:"end_date,omitempty"`
+		Page      *int    `url:"page,omitempty"`
+	}
+}
+
+func NewChangeListBuilder(c *client.Client, path string) *ChangeListBuilder {
+	b := &ChangeListBuilder{}
+	b.request = newRequest(b, c, path)
+	return b
+}
+
+// DateRange sets the start and end date parameters.
+// Dates must be YYYY-MM-DD, and at most 14 days apart
+func (b *ChangeListBuilder) DateRange(startDate, endDate string) *ChangeListBuilder {
+	b.opts.StartDate = &startDate
+	b.opts.EndDate = &endDate
+	return b
+}
+
+// Page sets the page parameter.
+func (b *ChangeListBuilder) Page(p int) *ChangeListBuilder {
+	b.opts.Page = &p
+	return b
+}
+
+// Exec performs the request and returns the response.
+func (b *ChangeListBuilder) Exec() (*types.ChangeListResponse, error) {
+	return b.ExecContext(context.Background())
+}
+
+// ExecContext is like Exec but uses ctx for the request.
+func (b *ChangeListBuilder) ExecContext(ctx context.Context) (*types.ChangeListResponse, error) {
+	return b.execPage(ctx, b.opts.Page)
+}
+
+func (b *ChangeListBuilder) startPage() int {
+	return pageOrFirst(b.opts.Page)
+}
+
+// execPage performs the request for page, or without a page parameter if nil.
+func (b *ChangeListBuilder) execPage(ctx context.Context, page *int) (*types.ChangeListResponse, error) {
+	opts := b.opts
+	opts.Page = page
+	return get[*types.ChangeListResponse](ctx, &b.request, opts)
+}
+
+// All returns an iterator over the results of every page, starting at the page set on the builder.
+// See the All function for details.
+func (b *ChangeListBuilder) All(ctx context.Context, opts ...IterOption) iter.Seq2[types.ChangeItem, error] {
+	return All(ctx, b, opts...)
+}
+
+// Pages fetches every page concurrently and returns them in page order.
+// See the Pages function for details.
+func (b *ChangeListBuilder) Pages(ctx context.Context, concurrency int, opts ...IterOption) ([]*types.ChangeListResponse, error) {
+	return Pages(ctx, b, concurrency, opts...)
+}
+
+// FetchAll fetches every page concurrently and returns their results in page order.
+// See the FetchAll function for details.
+func (b *ChangeListBuilder) FetchAll(ctx context.Context, concurrency int, opts ...IterOption) ([]types.ChangeItem, error) {
+	return FetchAll(ctx, b, concurrency, opts...)
+}
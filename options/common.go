@@ -1,18 +1,16 @@
 package options
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/falconer001/gotmdb/client"
 	"github.com/falconer001/gotmdb/types"
-	"github.com/falconer001/gotmdb/utils"
 )
 
 // AppendToResponseBuilder: language + append_to_response (comma-separated)
 type AppendToResponseBuilder[T allowedAppendToResponseT] struct {
-	client *client.Client
-	path   string
-	opts   struct {
+	request[*AppendToResponseBuilder[T]]
+	opts struct {
 		Language         *string  `url:"language,omitempty"`
 		AppendToResponse []string `url:"append_to_response,omitempty"`
 	}
@@ -20,14 +18,16 @@ type AppendToResponseBuilder[T allowedAppendToResponseT] struct {
 
 type allowedAppendToResponseT interface {
 	*types.MovieDetails |
-		*types.TVDetails
+		*types.TVDetails |
+		*types.PersonDetails |
+		*types.TVSeasonDetails |
+		*types.TVEpisodeDetails
 }
 
 func NewAppendToResponseBuilder[T allowedAppendToResponseT](c *client.Client, path string) *AppendToResponseBuilder[T] {
-	return &AppendToResponseBuilder[T]{
-		client: c,
-		path:   path,
-	}
+	b := &AppendToResponseBuilder[T]{}
+	b.request = newRequest(b, c, path)
+	return b
 }
 
 // Language sets the language parameter. e.g. "en-US", "fr-FR"
@@ -45,24 +45,18 @@ func (b *AppendToResponseBuilder[T]) AppendToResponse(parts ...string) *AppendTo
 
 // Exec performs the request and returns the response.
 func (b *AppendToResponseBuilder[T]) Exec() (T, error) {
-	resp := new(T)
-	params, err := utils.StructToURLValues(b.opts)
-	if err != nil {
-		return nil, fmt.Errorf("failed to convert options: %w", err)
-	}
+	return b.ExecContext(context.Background())
+}
 
-	err = b.client.DoRequest("GET", b.path, params, nil, resp)
-	if err != nil {
-		return nil, err
-	}
-	return *resp, nil
+// ExecContext is like Exec but uses ctx for the request.
+func (b *AppendToResponseBuilder[T]) ExecContext(ctx context.Context) (T, error) {
+	return get[T](ctx, &b.request, b.opts)
 }
 
 // * NO OPTIONS
 // noOptsBuilder For endpoints with no query parameters
 type NoOptsBuilder[T allowedNoOptsT] struct {
-	client *client.Client
-	path   string
+	request[*NoOptsBuilder[T]]
 }
 
 type allowedNoOptsT interface {
@@ -74,35 +68,55 @@ type allowedNoOptsT interface {
 		*types.TranslationsResponse |
 		*types.WatchProviderResponse |
 		*types.AlternativeTitlesResponse |
-		*types.ScreenedTheatricallyResponse
+		*types.ScreenedTheatricallyResponse |
+		*types.PersonDetails |
+		*types.PersonExternalIDs |
+		*types.PersonImagesResponse |
+		*types.PersonTranslationsResponse |
+		*types.CollectionTranslationsResponse |
+		*types.CompanyDetails |
+		*types.CompanyAlternativeNamesResponse |
+		*types.CompanyImagesResponse |
+		*types.Network |
+		*types.NetworkAlternativeNamesResponse |
+		*types.NetworkImagesResponse |
+		*types.APIConfiguration |
+		*types.CertificationsResponse |
+		[]types.JobDepartment |
+		[]types.LanguageConfig |
+		[]types.Timezone |
+		[]string |
+		*types.Keyword |
+		*types.TVSeasonExternalIDs |
+		*types.TVSeasonTranslationsResponse |
+		*types.TVEpisodeExternalIDs |
+		*types.TVEpisodeTranslationsResponse |
+		*types.EpisodeGroupDetails |
+		*types.ReviewDetails |
+		*types.CreditDetails
 }
 
 func NewNoOptsBuilder[T allowedNoOptsT](c *client.Client, path string) *NoOptsBuilder[T] {
-	return &NoOptsBuilder[T]{
-		client: c,
-		path:   path,
-	}
+	b := &NoOptsBuilder[T]{}
+	b.request = newRequest(b, c, path)
+	return b
 }
 
 // Exec performs the request and returns the response.
 func (b *NoOptsBuilder[T]) Exec() (T, error) {
-	var zero T
-	resp := new(T)
-
-	err := b.client.DoRequest("GET", b.path, nil, nil, resp)
-	if err != nil {
-		return zero, err
-	}
+	return b.ExecContext(context.Background())
+}
 
-	return *resp, nil
+// ExecContext is like Exec but uses ctx for the request.
+func (b *NoOptsBuilder[T]) ExecContext(ctx context.Context) (T, error) {
+	return get[T](ctx, &b.request)
 }
 
 // *PAGED BUILDER
 // PagedBuilder For endpoints supporting `page`, `language`, and `region`
 type PagedBuilder[T allowedPagedT] struct {
-	client *client.Client
-	path   string
-	opts   struct {
+	request[*PagedBuilder[T]]
+	opts struct {
 		Language *string `url:"language,omitempty"`
 		Page     *int    `url:"page,omitempty"`
 		Region   *string `url:"region,omitempty"`
@@ -116,14 +130,18 @@ type allowedPagedT interface {
 		*types.ListPaginatedResults |
 		*types.MoviePaginatedResults |
 		*types.ReviewPaginatedResults |
-		*types.TVShowPaginatedResults
+		*types.TVShowPaginatedResults |
+		*types.PersonPaginatedResults |
+		*types.TaggedImagePaginatedResponse |
+		*types.ListDetails |
+		*types.TrendingResponse |
+		*types.KeywordMoviesResponse
 }
 
 func NewPagedBuilder[T allowedPagedT](c *client.Client, path string) *PagedBuilder[T] {
-	return &PagedBuilder[T]{
-		client: c,
-		path:   path,
-	}
+	b := &PagedBuilder[T]{}
+	b.request = newRequest(b, c, path)
+	return b
 }
 
 // Language sets the language parameter. e.g. "en-US", "fr-FR"
@@ -154,27 +172,30 @@ func (b *PagedBuilder[T]) Timezone(t string) *PagedBuilder[T] {
 
 // Exec performs the request and returns the response.
 func (b *PagedBuilder[T]) Exec() (T, error) {
-	var zero T
-	resp := new(T)
-	params, err := utils.StructToURLValues(b.opts)
-	if err != nil {
-		return zero, fmt.Errorf("failed to convert options: %w", err)
-	}
+	return b.ExecContext(context.Background())
+}
 
-	err = b.client.DoRequest("GET", b.path, params, nil, resp)
-	if err != nil {
-		return zero, err
-	}
+// ExecContext is like Exec but uses ctx for the request.
+func (b *PagedBuilder[T]) ExecContext(ctx context.Context) (T, error) {
+	return b.execPage(ctx, b.opts.Page)
+}
 
-	return *resp, nil
+func (b *PagedBuilder[T]) startPage() int {
+	return pageOrFirst(b.opts.Page)
+}
+
+// execPage performs the request for page, or without a page parameter if nil.
+func (b *PagedBuilder[T]) execPage(ctx context.Context, page *int) (T, error) {
+	opts := b.opts
+	opts.Page = page
+	return get[T](ctx, &b.request, opts)
 }
 
 // *LANG BUILDER
 // langBuilder For endpoints supporting only `language`
 type LangBuilder[T any] struct {
-	client *client.Client
-	path   string
-	opts   struct {
+	request[*LangBuilder[T]]
+	opts struct {
 		IncludeImageLanguage *string `url:"include_image_language,omitempty"` // Comma separated ISO 639-1 codes
 		Language             *string `url:"language,omitempty"`
 	}
@@ -183,14 +204,25 @@ type LangBuilder[T any] struct {
 type allowedLangT interface {
 	*types.Credits |
 		*types.ImageList |
-		*types.VideoList
+		*types.VideoList |
+		*types.PersonMovieCreditsResponse |
+		*types.PersonTVCreditsResponse |
+		*types.PersonCombinedCreditsResponse |
+		*types.CollectionDetails |
+		*types.CollectionImagesResponse |
+		*types.AggregateCreditsResponse |
+		*types.WatchProviderResponse |
+		*types.TVSeasonImagesResponse |
+		*types.TVSeasonVideosResponse |
+		*types.TVEpisodeCreditsResponse |
+		*types.TVEpisodeImagesResponse |
+		*types.TVEpisodeVideosResponse
 }
 
 func NewLangBuilder[T any](c *client.Client, path string) *LangBuilder[T] {
-	return &LangBuilder[T]{
-		client: c,
-		path:   path,
-	}
+	b := &LangBuilder[T]{}
+	b.request = newRequest(b, c, path)
+	return b
 }
 
 // Language sets the language parameter. e.g. "en-US", "fr-FR"
@@ -208,28 +240,57 @@ func (b *LangBuilder[T]) IncludeImageLanguage(lang string) *LangBuilder[T] {
 
 // Exec performs the request and returns the response.
 func (b *LangBuilder[T]) Exec() (T, error) {
-	var zero T
-	resp := new(T)
-	params, err := utils.StructToURLValues(b.opts)
-	if err != nil {
-		return zero, fmt.Errorf("failed to convert options: %w", err)
-	}
+	return b.ExecContext(context.Background())
+}
+
+// ExecContext is like Exec but uses ctx for the request.
+func (b *LangBuilder[T]) ExecContext(ctx context.Context) (T, error) {
+	return get[T](ctx, &b.request, b.opts)
+}
 
-	err = b.client.DoRequest("GET", b.path, params, nil, resp)
-	if err != nil {
-		return zero, err
+// *LANGUAGE BUILDER
+// LanguageBuilder For endpoints whose only parameter is `language`, e.g. translated names
+type LanguageBuilder[T allowedLanguageT] struct {
+	request[*LanguageBuilder[T]]
+	opts struct {
+		Language *string `url:"language,omitempty"`
 	}
+}
+
+type allowedLanguageT interface {
+	[]types.Country |
+		*types.GenreListResponse |
+		*types.WatchProviderRegionsResponse
+}
 
-	return *resp, nil
+func NewLanguageBuilder[T allowedLanguageT](c *client.Client, path string) *LanguageBuilder[T] {
+	b := &LanguageBuilder[T]{}
+	b.request = newRequest(b, c, path)
+	return b
+}
+
+// Language sets the language parameter. e.g. "en-US", "fr-FR"
+func (b *LanguageBuilder[T]) Language(lang string) *LanguageBuilder[T] {
+	b.opts.Language = &lang
+	return b
+}
+
+// Exec performs the request and returns the response.
+func (b *LanguageBuilder[T]) Exec() (T, error) {
+	return b.ExecContext(context.Background())
+}
+
+// ExecContext is like Exec but uses ctx for the request.
+func (b *LanguageBuilder[T]) ExecContext(ctx context.Context) (T, error) {
+	return get[T](ctx, &b.request, b.opts)
 }
 
 // *CHANGES BUILDER
 // ChangesBuilder For the /changes endpoint
 // Dates must be YYYY-MM-DD
 type ChangesBuilder struct {
-	client *client.Client
-	path   string
-	opts   struct {
+	request[*ChangesBuilder]
+	opts struct {
 		StartDate *string `url:"start_date,omitempty"`
 		EndDate   *string `url:"end_date,omitempty"`
 		Page      *int    `url:"page,omitempty"`
@@ -237,10 +298,9 @@ type ChangesBuilder struct {
 }
 
 func NewChangesBuilder(c *client.Client, path string) *ChangesBuilder {
-	return &ChangesBuilder{
-		client: c,
-		path:   path,
-	}
+	b := &ChangesBuilder{}
+	b.request = newRequest(b, c, path)
+	return b
 }
 
 // DateRange sets the start and end date parameters.
@@ -259,15 +319,10 @@ func (b *ChangesBuilder) Page(p int) *ChangesBuilder {
 
 // Exec performs the request and returns the response.
 func (b *ChangesBuilder) Exec() (*types.ItemChangesResponse, error) {
-	resp := new(types.ItemChangesResponse)
-	params, err := utils.StructToURLValues(b.opts)
-	if err != nil {
-		return nil, fmt.Errorf("failed to convert options: %w", err)
-	}
+	return b.ExecContext(context.Background())
+}
 
-	err = b.client.DoRequest("GET", b.path, params, nil, resp)
-	if err != nil {
-		return nil, err
-	}
-	return resp, nil
+// ExecContext is like Exec but uses ctx for the request.
+func (b *ChangesBuilder) ExecContext(ctx context.Context) (*types.ItemChangesResponse, error) {
+	return get[*types.ItemChangesResponse](ctx, &b.request, b.opts)
 }
@@ -0,0 +1,106 @@
+package options
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// maxResults is the number of results TMDb serves for a query: 500 pages of 20.
+	maxResults = maxPage * 20
+
+	dateLayout = "2006-01-02"
+)
+
+// ErrCrawlTruncated is reported by Crawl when a single day has more results than TMDb serves for a query,
+// so that only the first 10,000 of them could be fetched.
+var ErrCrawlTruncated = errors.New("tmdb: crawl truncated to 500 pages")
+
+// crawlStart and crawlEnd bound the dates of a split crawl when the builder doesn't.
+var (
+	crawlStart = time.Date(1870, time.January, 1, 0, 0, 0, 0, time.UTC)
+	crawlEnd   = time.Date(2100, time.December, 31, 0, 0, 0, 0, time.UTC)
+)
+
+// dateSlicer is implemented by the discover builders, whose queries can be split by date.
+type dateSlicer[P any] interface {
+	Pager[P]
+	// dateRange returns the date range set on the builder.
+	dateRange() (gte, lte *string)
+	// withDateRange returns a copy of the builder limited to the dates from gte to lte.
+	withDateRange(gte, lte time.Time) dateSlicer[P]
+}
+
+// crawl fetches every result of b, working around TMDb's 500 pages limit.
+// The query is first run as set on the builder; only when it has more results than TMDb serves
+// is it bounded by dates, and its date range split in two for as long as needed.
+func crawl[R any, P ResultsPage[R]](ctx context.Context, b dateSlicer[P], concurrency int) ([]R, error) {
+	var err error
+	gte, lte := crawlStart, crawlEnd
+	from, to := b.dateRange()
+	if from != nil {
+		if gte, err = time.Parse(dateLayout, *from); err != nil {
+			return nil, fmt.Errorf("tmdb: invalid start date %q: %w", *from, err)
+		}
+	}
+	if to != nil {
+		if lte, err = time.Parse(dateLayout, *to); err != nil {
+			return nil, fmt.Errorf("tmdb: invalid end date %q: %w", *to, err)
+		}
+	}
+
+	page := 1
+	first, err := b.execPage(ctx, &page)
+	if err != nil {
+		return nil, err
+	}
+	if first.Pagination().TotalResults <= maxResults {
+		pages, err := fetchRest(ctx, b, first, page, concurrency, iterConfig{})
+		return results(pages, 0), err
+	}
+
+	c := &crawler[R, P]{concurrency: concurrency}
+	if err := c.crawl(ctx, b, gte, lte); err != nil {
+		return results(c.pages, 0), err
+	}
+	return results(c.pages, 0), errors.Join(c.errs...)
+}
+
+type crawler[R any, P ResultsPage[R]] struct {
+	concurrency int
+	pages       []P
+	// errs holds the failures that didn't stop the crawl: failed pages and truncated days.
+	errs []error
+}
+
+// crawl fetches the results of b between gte and lte, splitting the range if needed.
+// Slices are crawled from the oldest to the most recent.
+func (c *crawler[R, P]) crawl(ctx context.Context, b dateSlicer[P], gte, lte time.Time) error {
+	slice := b.withDateRange(gte, lte)
+	page := 1
+	first, err := slice.execPage(ctx, &page)
+	if err != nil {
+		return err
+	}
+
+	if total := first.Pagination().TotalResults; total > maxResults {
+		if gte.Equal(lte) {
+			c.errs = append(c.errs, fmt.Errorf("%w: %d results on %s", ErrCrawlTruncated, total, gte.Format(dateLayout)))
+		} else {
+			mid := gte.AddDate(0, 0, int(lte.Sub(gte).Hours()/24)/2)
+			if err := c.crawl(ctx, b, gte, mid); err != nil {
+				return err
+			}
+			return c.crawl(ctx, b, mid.AddDate(0, 0, 1), lte)
+		}
+	}
+
+	pages, err := fetchRest(ctx, slice, first, page, c.concurrency, iterConfig{})
+	c.pages = append(c.pages, pages...)
+	if err != nil {
+		c.errs = append(c.errs, err)
+	}
+	return nil
+}
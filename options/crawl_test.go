@@ -0,0 +1,172 @@
+package options_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/falconer001/gotmdb/options"
+	"github.com/falconer001/gotmdb/tmdbtest"
+)
+
+// datedMovie is a movie served by serveDiscover. An empty date means the movie has none.
+type datedMovie struct {
+	id   int
+	date string
+}
+
+// serveDiscover makes srv answer /discover/movie with the movies whose primary release date is
+// in the requested range, 20 per page and at most 500 pages, like TMDb.
+// Movies with no date only match queries without a date range.
+func serveDiscover(srv *tmdbtest.Server, movies []datedMovie) {
+	srv.Handle("GET /discover/movie", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		gte, lte := q.Get("primary_release_date.gte"), q.Get("primary_release_date.lte")
+		var matching []datedMovie
+		for _, m := range movies {
+			bounded := gte != "" || lte != ""
+			if bounded && (m.date == "" || (gte != "" && m.date < gte) || (lte != "" && m.date > lte)) {
+				continue
+			}
+			matching = append(matching, m)
+		}
+
+		page, _ := strconv.Atoi(q.Get("page"))
+		results := []map[string]any{}
+		for i := (page - 1) * 20; page <= 500 && i < min(page*20, len(matching)); i++ {
+			results = append(results, map[string]any{"id": matching[i].id, "release_date": matching[i].date})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"page":          page,
+			"results":       results,
+			"total_pages":   min((len(matching)+19)/20, 500),
+			"total_results": len(matching),
+		})
+	})
+}
+
+// spread returns n movies with IDs from first, released over days starting on start.
+func spread(first, n int, start time.Time, days int) []datedMovie {
+	movies := make([]datedMovie, n)
+	for i := range movies {
+		movies[i] = datedMovie{id: first + i, date: start.AddDate(0, 0, i%days).Format("2006-01-02")}
+	}
+	return movies
+}
+
+// dateBounded reports whether a discover request was limited to a date range.
+func dateBounded(req tmdbtest.Request) bool {
+	return req.Query.Has("primary_release_date.gte") || req.Query.Has("primary_release_date.lte")
+}
+
+func TestCrawlUnbounded(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	tmdb := newTMDB(t, srv)
+
+	// Under 10,000 results, the query is run as is
+	srv.SetTotalResults("GET /discover/movie", 150)
+	movies, err := tmdb.Discover.DiscoverMovies().Crawl(context.Background(), 4)
+	if err != nil {
+		t.Fatalf("Crawl: %v", err)
+	}
+	if len(movies) != 150 {
+		t.Errorf("got %d movies, want 150", len(movies))
+	}
+	reqs := srv.Requests()
+	if len(reqs) != 8 {
+		t.Errorf("sent %d requests, want 8", len(reqs))
+	}
+	for _, req := range reqs {
+		if dateBounded(req) {
+			t.Errorf("sent %s?%s, want no date range", req.Path, req.Query.Encode())
+		}
+	}
+}
+
+func TestCrawlUndatedResults(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	tmdb := newTMDB(t, srv)
+
+	// Movies with no date are kept as long as the query doesn't need splitting
+	movies := append(spread(1, 90, time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC), 30), datedMovie{id: 91}, datedMovie{id: 92})
+	serveDiscover(srv, movies)
+	got, err := tmdb.Discover.DiscoverMovies().Crawl(context.Background(), 2)
+	if err != nil {
+		t.Fatalf("Crawl: %v", err)
+	}
+	if len(got) != 92 {
+		t.Errorf("got %d movies, want 92 including the 2 without a date", len(got))
+	}
+}
+
+func TestCrawlSplit(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	tmdb := newTMDB(t, srv)
+
+	// 12,000 movies over 40 days, one of them listed on two days as if its date changed during the crawl,
+	// and a few without a date, which no date range matches
+	movies := spread(1, 12000, time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC), 40)
+	movies = append(movies, datedMovie{id: 7, date: "2000-02-05"}, datedMovie{id: 20001}, datedMovie{id: 20002})
+	serveDiscover(srv, movies)
+
+	got, err := tmdb.Discover.DiscoverMovies().Crawl(context.Background(), 8)
+	if err != nil {
+		t.Fatalf("Crawl: %v", err)
+	}
+	if len(got) != 12000 {
+		t.Errorf("got %d movies, want 12000", len(got))
+	}
+	seen := make(map[int]bool, len(got))
+	for _, m := range got {
+		if seen[m.ID] {
+			t.Fatalf("movie %d returned twice", m.ID)
+		}
+		seen[m.ID] = true
+	}
+	if first, last := got[0].ReleaseDate, got[len(got)-1].ReleaseDate; first > last {
+		t.Errorf("the results go from %s to %s, want the oldest date range first", first, last)
+	}
+
+	// The first request is the caller's query, the next ones split its dates
+	reqs := srv.Requests()
+	if dateBounded(reqs[0]) {
+		t.Errorf("the first request has a date range: %s", reqs[0].Query.Encode())
+	}
+	if !dateBounded(reqs[len(reqs)-1]) {
+		t.Error("the crawl wasn't split by dates")
+	}
+}
+
+func TestCrawlTruncated(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	tmdb := newTMDB(t, srv)
+
+	// One day has more results than TMDb serves
+	movies := spread(1, 10050, time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC), 1)
+	movies = append(movies, spread(20001, 100, time.Date(2000, time.January, 2, 0, 0, 0, 0, time.UTC), 1)...)
+	serveDiscover(srv, movies)
+
+	got, err := tmdb.Discover.DiscoverMovies().
+		PrimaryReleaseDateGTE(time.Date(1999, time.December, 1, 0, 0, 0, 0, time.UTC)).
+		PrimaryReleaseDateLTE(time.Date(2000, time.February, 1, 0, 0, 0, 0, time.UTC)).
+		Crawl(context.Background(), 8)
+	if !errors.Is(err, options.ErrCrawlTruncated) {
+		t.Fatalf("err = %v, want ErrCrawlTruncated", err)
+	}
+	if len(got) != 10100 {
+		t.Errorf("got %d movies, want the 10,000 served for 2000-01-01 and the 100 of the next day", len(got))
+	}
+	for _, req := range srv.Requests() {
+		if gte := req.Query.Get("primary_release_date.gte"); gte < "1999-12-01" {
+			t.Fatalf("sent a request from %q, want the builder's range kept", gte)
+		}
+	}
+}
@@ -1,13 +1,12 @@
 package options
 
 import (
-	"fmt"
-	"net/url"
+	"context"
+	"iter"
 	"time"
 
 	"github.com/falconer001/gotmdb/client"
 	"github.com/falconer001/gotmdb/types"
-	"github.com/falconer001/gotmdb/utils"
 )
 
 type allowedBaseBuilderTypes interface {
@@ -36,7 +35,7 @@ type BaseOpts struct {
 
 type BaseDiscoverBuilder[T allowedBaseBuilderTypes] struct {
 	BaseOpts
-	self T `url:"-"`
+	request[T]
 }
 
 func (b *BaseDiscoverBuilder[T]) isBuilder() {
@@ -140,7 +139,6 @@ func (b *BaseDiscoverBuilder[T]) IncludeAdult(v bool) T {
 
 // Builder for /discover/movie
 type DiscoverMoviesBuilder struct {
-	client *client.Client `json:"-" url:"-"`
 	*BaseDiscoverBuilder[*DiscoverMoviesBuilder]
 	opts struct {
 		Year                  *int     `url:"year,omitempty"`
@@ -173,9 +171,8 @@ func (b *DiscoverMoviesBuilder) isBuilder() {}
 // NewDiscoverMoviesBuilder initializes the movie discover builder.
 func NewDiscoverMoviesBuilder(c *client.Client) *DiscoverMoviesBuilder {
 	b := &DiscoverMoviesBuilder{}
-	b.client = c
 	b.BaseDiscoverBuilder = &BaseDiscoverBuilder[*DiscoverMoviesBuilder]{
-		self: b,
+		request: newRequest(b, c, "/discover/movie"),
 	}
 	return b
 }
@@ -302,40 +299,74 @@ func (b *DiscoverMoviesBuilder) WithRuntimeLTE(i int) *DiscoverMoviesBuilder {
 
 // Exec performs the request and returns the response.
 func (b *DiscoverMoviesBuilder) Exec() (*types.MoviePaginatedResults, error) {
-	path := "/discover/movie"
-	params := make(url.Values)
-	res := new(types.MoviePaginatedResults)
+	return b.ExecContext(context.Background())
+}
 
-	baseOpts, err := utils.StructToURLValues(b.BaseDiscoverBuilder.BaseOpts)
-	if err != nil {
-		return nil, fmt.Errorf("convert base opts: %w", err)
-	}
-	opts, err := utils.StructToURLValues(b.opts)
-	if err != nil {
-		return nil, fmt.Errorf("convert movie opts: %w", err)
-	}
+// ExecContext is like Exec but uses ctx for the request.
+func (b *DiscoverMoviesBuilder) ExecContext(ctx context.Context) (*types.MoviePaginatedResults, error) {
+	return b.execPage(ctx, b.BaseOpts.Page)
+}
 
-	// Combining baseOpts and opts
-	for k, values := range baseOpts {
-		for _, v := range values {
-			params.Add(k, v)
-		}
-	}
+// All returns an iterator over the results of every page, starting at the page set on the builder.
+// See the All function for details.
+func (b *DiscoverMoviesBuilder) All(ctx context.Context, opts ...IterOption) iter.Seq2[types.MovieListResult, error] {
+	return All(ctx, b, opts...)
+}
 
-	for k, values := range opts {
-		for _, v := range values {
-			params.Add(k, v)
-		}
-	}
+// Pages fetches every page concurrently and returns them in page order.
+// See the Pages function for details.
+func (b *DiscoverMoviesBuilder) Pages(ctx context.Context, concurrency int, opts ...IterOption) ([]*types.MoviePaginatedResults, error) {
+	return Pages(ctx, b, concurrency, opts...)
+}
 
-	fmt.Println("params: ", params)
+// FetchAll fetches every page concurrently and returns their results in page order.
+// See the FetchAll function for details.
+func (b *DiscoverMoviesBuilder) FetchAll(ctx context.Context, concurrency int, opts ...IterOption) ([]types.MovieListResult, error) {
+	return FetchAll(ctx, b, concurrency, opts...)
+}
 
-	err = b.client.DoRequest("GET", path, params, nil, res)
-	if err != nil {
-		return nil, fmt.Errorf("do request: %w", err)
-	}
+// Crawl fetches every result of the query, working around TMDb's limit of 500 pages (10,000 results).
+// While a query has more results than that, its primary_release_date range is split in two, each half being
+// crawled on its own; pages are fetched concurrently as with FetchAll. The results are returned
+// from the oldest date range to the most recent, without duplicates.
+// The primary_release_date.gte and .lte set on the builder bound the crawl. When the query has to be split,
+// results with no primary_release_date are left out, as no date range matches them.
+//
+// Failed pages are reported through a *PartialError and days with more than 10,000 results
+// through ErrCrawlTruncated, alongside the results that could be fetched.
+func (b *DiscoverMoviesBuilder) Crawl(ctx context.Context, concurrency int) ([]types.MovieListResult, error) {
+	return crawl[types.MovieListResult](ctx, b, concurrency)
+}
+
+func (b *DiscoverMoviesBuilder) dateRange() (gte, lte *string) {
+	return b.opts.PrimaryReleaseDateGTE, b.opts.PrimaryReleaseDateLTE
+}
+
+func (b *DiscoverMoviesBuilder) withDateRange(gte, lte time.Time) dateSlicer[*types.MoviePaginatedResults] {
+	c := b.clone()
+	c.PrimaryReleaseDateGTE(gte)
+	c.PrimaryReleaseDateLTE(lte)
+	return c
+}
+
+// clone returns a copy of the builder that can be changed without affecting b.
+func (b *DiscoverMoviesBuilder) clone() *DiscoverMoviesBuilder {
+	c := &DiscoverMoviesBuilder{opts: b.opts}
+	base := *b.BaseDiscoverBuilder
+	base.self = c
+	c.BaseDiscoverBuilder = &base
+	return c
+}
+
+func (b *DiscoverMoviesBuilder) startPage() int {
+	return pageOrFirst(b.BaseOpts.Page)
+}
 
-	return res, nil
+// execPage performs the request for page, or without a page parameter if nil.
+func (b *DiscoverMoviesBuilder) execPage(ctx context.Context, page *int) (*types.MoviePaginatedResults, error) {
+	base := b.BaseOpts
+	base.Page = page
+	return get[*types.MoviePaginatedResults](ctx, &b.request, base, b.opts)
 }
 
 // DiscoverTVBuilder builds /discover/tv requests.
@@ -364,8 +395,7 @@ func (b *DiscoverTVBuilder) isBuilder() {}
 // NewDiscoverTVBuilder initializes the TV discover builder.
 func NewDiscoverTVBuilder(c *client.Client) *DiscoverTVBuilder {
 	b := &DiscoverTVBuilder{}
-	b.client = c
-	b.BaseDiscoverBuilder = &BaseDiscoverBuilder[*DiscoverTVBuilder]{self: b}
+	b.BaseDiscoverBuilder = &BaseDiscoverBuilder[*DiscoverTVBuilder]{request: newRequest(b, c, "/discover/tv")}
 	return b
 }
 
@@ -430,38 +460,72 @@ func (b *DiscoverTVBuilder) WithRuntimeLTE(i int) *DiscoverTVBuilder {
 
 // Exec performs the request and returns the response.
 func (b *DiscoverTVBuilder) Exec() (*types.TVShowPaginatedResults, error) {
-	path := "/discover/tv"
-	params := make(url.Values)
-	res := new(types.TVShowPaginatedResults)
+	return b.ExecContext(context.Background())
+}
 
-	baseOpts, err := utils.StructToURLValues(b.BaseDiscoverBuilder.BaseOpts)
-	if err != nil {
-		return nil, fmt.Errorf("convert base opts: %w", err)
-	}
-	opts, err := utils.StructToURLValues(b.opts)
-	if err != nil {
-		return nil, fmt.Errorf("convert movie opts: %w", err)
-	}
+// ExecContext is like Exec but uses ctx for the request.
+func (b *DiscoverTVBuilder) ExecContext(ctx context.Context) (*types.TVShowPaginatedResults, error) {
+	return b.execPage(ctx, b.BaseOpts.Page)
+}
 
-	// Combining baseOpts and opts
-	for k, values := range baseOpts {
-		for _, v := range values {
-			params.Add(k, v)
-		}
-	}
+// All returns an iterator over the results of every page, starting at the page set on the builder.
+// See the All function for details.
+func (b *DiscoverTVBuilder) All(ctx context.Context, opts ...IterOption) iter.Seq2[types.TVListResult, error] {
+	return All(ctx, b, opts...)
+}
 
-	for k, values := range opts {
-		for _, v := range values {
-			params.Add(k, v)
-		}
-	}
+// Pages fetches every page concurrently and returns them in page order.
+// See the Pages function for details.
+func (b *DiscoverTVBuilder) Pages(ctx context.Context, concurrency int, opts ...IterOption) ([]*types.TVShowPaginatedResults, error) {
+	return Pages(ctx, b, concurrency, opts...)
+}
 
-	fmt.Println("params: ", params)
+// FetchAll fetches every page concurrently and returns their results in page order.
+// See the FetchAll function for details.
+func (b *DiscoverTVBuilder) FetchAll(ctx context.Context, concurrency int, opts ...IterOption) ([]types.TVListResult, error) {
+	return FetchAll(ctx, b, concurrency, opts...)
+}
 
-	err = b.client.DoRequest("GET", path, params, nil, res)
-	if err != nil {
-		return nil, fmt.Errorf("do request: %w", err)
-	}
+// Crawl fetches every result of the query, working around TMDb's limit of 500 pages (10,000 results).
+// While a query has more results than that, its first_air_date range is split in two, each half being
+// crawled on its own; pages are fetched concurrently as with FetchAll. The results are returned
+// from the oldest date range to the most recent, without duplicates.
+// The first_air_date.gte and .lte set on the builder bound the crawl. When the query has to be split,
+// results with no first_air_date are left out, as no date range matches them.
+//
+// Failed pages are reported through a *PartialError and days with more than 10,000 results
+// through ErrCrawlTruncated, alongside the results that could be fetched.
+func (b *DiscoverTVBuilder) Crawl(ctx context.Context, concurrency int) ([]types.TVListResult, error) {
+	return crawl[types.TVListResult](ctx, b, concurrency)
+}
+
+func (b *DiscoverTVBuilder) dateRange() (gte, lte *string) {
+	return b.opts.FirstAirDateGTE, b.opts.FirstAirDateLTE
+}
+
+func (b *DiscoverTVBuilder) withDateRange(gte, lte time.Time) dateSlicer[*types.TVShowPaginatedResults] {
+	c := b.clone()
+	c.FirstAirDateGTE(gte)
+	c.FirstAirDateLTE(lte)
+	return c
+}
+
+// clone returns a copy of the builder that can be changed without affecting b.
+func (b *DiscoverTVBuilder) clone() *DiscoverTVBuilder {
+	c := &DiscoverTVBuilder{opts: b.opts}
+	base := *b.BaseDiscoverBuilder
+	base.self = c
+	c.BaseDiscoverBuilder = &base
+	return c
+}
+
+func (b *DiscoverTVBuilder) startPage() int {
+	return pageOrFirst(b.BaseOpts.Page)
+}
 
-	return res, nil
+// execPage performs the request for page, or without a page parameter if nil.
+func (b *DiscoverTVBuilder) execPage(ctx context.Context, page *int) (*types.TVShowPaginatedResults, error) {
+	base := b.BaseOpts
+	base.Page = page
+	return get[*types.TVShowPaginatedResults](ctx, &b.request, base, b.opts)
 }
@@ -0,0 +1,46 @@
+package options
+
+import (
+	"context"
+
+	"github.com/falconer001/gotmdb/client"
+	"github.com/falconer001/gotmdb/types"
+)
+
+// *FIND BUILDER
+// FindBuilder For the /find/{external_id} endpoint
+type FindBuilder struct {
+	request[*FindBuilder]
+	opts struct {
+		ExternalSource *types.ExternalSource `url:"external_source,omitempty"`
+		Language       *string               `url:"language,omitempty"`
+	}
+}
+
+func NewFindBuilder(c *client.Client, path string) *FindBuilder {
+	b := &FindBuilder{}
+	b.request = newRequest(b, c, path)
+	return b
+}
+
+// ExternalSource sets the external_source parameter, the database the ID comes from. e.g. types.ExternalSourceIMDb
+func (b *FindBuilder) ExternalSource(source types.ExternalSource) *FindBuilder {
+	b.opts.ExternalSource = &source
+	return b
+}
+
+// Language sets the language parameter. e.g. "en-US", "fr-FR"
+func (b *FindBuilder) Language(lang string) *FindBuilder {
+	b.opts.Language = &lang
+	return b
+}
+
+// Exec performs the request and returns the response.
+func (b *FindBuilder) Exec() (*types.FindResponse, error) {
+	return b.ExecContext(context.Background())
+}
+
+// ExecContext is like Exec but uses ctx for the request.
+func (b *FindBuilder) ExecContext(ctx context.Context) (*types.FindResponse, error) {
+	return get[*types.FindResponse](ctx, &b.request, b.opts)
+}
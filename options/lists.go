@@ -0,0 +1,87 @@
+package options
+
+import (
+	"context"
+
+	"github.com/falconer001/gotmdb/client"
+	"github.com/falconer001/gotmdb/types"
+)
+
+// *LIST ITEM STATUS BUILDER
+// ListItemStatusBuilder For the /list/{list_id}/item_status endpoint
+type ListItemStatusBuilder struct {
+	request[*ListItemStatusBuilder]
+	opts struct {
+		Language *string `url:"language,omitempty"`
+		MovieID  *int    `url:"movie_id,omitempty"`
+	}
+}
+
+func NewListItemStatusBuilder(c *client.Client, path string) *ListItemStatusBuilder {
+	b := &ListItemStatusBuilder{}
+	b.request = newRequest(b, c, path)
+	return b
+}
+
+// Language sets the language parameter. e.g. "en-US", "fr-FR"
+func (b *ListItemStatusBuilder) Language(lang string) *ListItemStatusBuilder {
+	b.opts.Language = &lang
+	return b
+}
+
+// MovieID sets the movie_id parameter, the movie to look for on the list.
+func (b *ListItemStatusBuilder) MovieID(id int) *ListItemStatusBuilder {
+	b.opts.MovieID = &id
+	return b
+}
+
+// Exec performs the request and returns the response.
+func (b *ListItemStatusBuilder) Exec() (*types.ListItemStatusResponse, error) {
+	return b.ExecContext(context.Background())
+}
+
+// ExecContext is like Exec but uses ctx for the request.
+func (b *ListItemStatusBuilder) ExecContext(ctx context.Context) (*types.ListItemStatusResponse, error) {
+	return get[*types.ListItemStatusResponse](ctx, &b.request, b.opts)
+}
+
+// *LIST CLEAR BUILDER
+// ListClearBuilder For the /list/{list_id}/clear endpoint
+// Without a session ID, the client's bearer token is used.
+type ListClearBuilder struct {
+	client *client.Client
+	path   string
+	opts   struct {
+		Confirm   *bool   `url:"confirm,omitempty"`
+		SessionID *string `url:"session_id,omitempty"`
+	}
+}
+
+func NewListClearBuilder(c *client.Client, path string) *ListClearBuilder {
+	return &ListClearBuilder{
+		client: c,
+		path:   path,
+	}
+}
+
+// Confirm sets the confirm parameter. TMDb only clears the list when it's true.
+func (b *ListClearBuilder) Confirm(confirm bool) *ListClearBuilder {
+	b.opts.Confirm = &confirm
+	return b
+}
+
+// SessionID sets the session ID parameter.
+func (b *ListClearBuilder) SessionID(id string) *ListClearBuilder {
+	b.opts.SessionID = &id
+	return b
+}
+
+// Exec performs the request and returns the response.
+func (b *ListClearBuilder) Exec() (*types.StatusResponse, error) {
+	return b.ExecContext(context.Background())
+}
+
+// ExecContext is like Exec but uses ctx for the request.
+func (b *ListClearBuilder) ExecContext(ctx context.Context) (*types.StatusResponse, error) {
+	return sendPrivate[*types.StatusResponse](ctx, b.client, "POST", b.path, nil, b.opts)
+}
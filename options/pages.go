@@ -0,0 +1,280 @@
+package options
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"reflect"
+	"sync"
+
+	"github.com/falconer001/gotmdb/types"
+)
+
+// maxPage is the last page TMDb serves for any paginated endpoint.
+const maxPage = 500
+
+// Pager is implemented by the builders of paginated endpoints: PagedBuilder, AccountListBuilder,
+// ChangeListBuilder, the search builders and the discover builders. See All.
+type Pager[P any] interface {
+	// execPage performs the request for page, or without a page parameter if nil.
+	execPage(ctx context.Context, page *int) (P, error)
+	// startPage returns the page set on the builder, 1 if none.
+	startPage() int
+}
+
+// ResultsPage is implemented by the paginated responses, e.g. *types.MoviePaginatedResults.
+type ResultsPage[R any] interface {
+	Items() []R
+	paginated
+}
+
+// paginated is implemented by the paginated responses.
+type paginated interface {
+	Pagination() types.Paginated
+}
+
+// IterOption limits an iteration over paginated results.
+type IterOption func(*iterConfig)
+
+type iterConfig struct {
+	maxPages int
+	maxItems int
+}
+
+// MaxPages stops the iteration after n pages.
+func MaxPages(n int) IterOption {
+	return func(c *iterConfig) { c.maxPages = n }
+}
+
+// MaxItems stops the iteration after n results.
+func MaxItems(n int) IterOption {
+	return func(c *iterConfig) { c.maxItems = n }
+}
+
+// All returns an iterator over the results of every page of b, starting at the page set on it (1 by default):
+//
+//	for movie, err := range options.All(ctx, tmdb.Movies.GetPopular(), options.MaxItems(100)) {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(movie.Title)
+//	}
+//
+// Pages are fetched as the iteration goes on. Since TMDb's pages shift while they're walked,
+// results already seen on a previous page (same ID, and same media type for mixed results) are skipped.
+// The iteration stops after the last page, TMDb's 500th page, or when a limit is reached.
+// If a page can't be fetched or ctx is done, the error is yielded and the iteration stops.
+//
+// The search and discover builders have an All method doing the same.
+func All[R any, P ResultsPage[R]](ctx context.Context, b Pager[P], opts ...IterOption) iter.Seq2[R, error] {
+	var cfg iterConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return func(yield func(R, error) bool) {
+		var zero R
+		seen := make(map[any]struct{})
+		items := 0
+		for page, pages := b.startPage(), 0; cfg.maxPages <= 0 || pages < cfg.maxPages; page, pages = page+1, pages+1 {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+			resp, err := b.execPage(ctx, &page)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range resp.Items() {
+				if key, ok := resultKey(item); ok {
+					if _, dup := seen[key]; dup {
+						continue
+					}
+					seen[key] = struct{}{}
+				}
+				if err := ctx.Err(); err != nil {
+					yield(zero, err)
+					return
+				}
+				if !yield(item, nil) {
+					return
+				}
+				items++
+				if cfg.maxItems > 0 && items >= cfg.maxItems {
+					return
+				}
+			}
+
+			if len(resp.Items()) == 0 || page >= resp.Pagination().TotalPages || page >= maxPage {
+				return
+			}
+		}
+	}
+}
+
+// resultKey identifies a result by its ID field, along with its MediaType field if it has one
+// since movies and TV shows can share IDs.
+func resultKey(result any) (any, bool) {
+	v := reflect.Indirect(reflect.ValueOf(result))
+	if v.Kind() != reflect.Struct {
+		return nil, false
+	}
+	id := v.FieldByName("ID")
+	if !id.IsValid() || !id.Comparable() {
+		return nil, false
+	}
+	key := struct{ mediaType, id any }{id: id.Interface()}
+	if mediaType := v.FieldByName("MediaType"); mediaType.IsValid() {
+		key.mediaType = mediaType.Interface()
+	}
+	return key, true
+}
+
+// pageOrFirst returns *page, or 1 if page is nil.
+func pageOrFirst(page *int) int {
+	if page == nil {
+		return 1
+	}
+	return *page
+}
+
+// FailedPage is a page that Pages or FetchAll couldn't fetch.
+type FailedPage struct {
+	Page int
+	Err  error
+}
+
+// PartialError is returned by Pages and FetchAll when some pages couldn't be fetched.
+// The pages that could are returned along with it.
+type PartialError struct {
+	// Pages is the number of pages that were to be fetched.
+	Pages int
+	// Failed lists the pages that couldn't be fetched, in page order.
+	Failed []FailedPage
+}
+
+// Error implements the error interface.
+func (e *PartialError) Error() string {
+	first := e.Failed[0]
+	return fmt.Sprintf("tmdb: %d of %d pages couldn't be fetched (page %d: %v)", len(e.Failed), e.Pages, first.Page, first.Err)
+}
+
+// Unwrap returns the errors of the failed pages, so that errors.Is and errors.As look at them.
+func (e *PartialError) Unwrap() []error {
+	errs := make([]error, len(e.Failed))
+	for i, f := range e.Failed {
+		errs[i] = f.Err
+	}
+	return errs
+}
+
+// Pages fetches every page of b, starting at the page set on it (1 by default), and returns them in page order.
+// The first page is fetched alone to learn the number of pages, then up to concurrency requests run at once.
+// Requests still go through the client, so they wait for its rate limiter and are retried per its policy.
+// Only MaxPages applies among opts.
+//
+// If the first page fails, its error is returned. If later pages fail, the other pages are returned
+// along with a *PartialError listing the failed ones; that includes the pages left when ctx is done.
+func Pages[P paginated](ctx context.Context, b Pager[P], concurrency int, opts ...IterOption) ([]P, error) {
+	var cfg iterConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	start := b.startPage()
+	first, err := b.execPage(ctx, &start)
+	if err != nil {
+		return nil, err
+	}
+	return fetchRest(ctx, b, first, start, concurrency, cfg)
+}
+
+// fetchRest fetches the pages following first, which is page start. See Pages.
+func fetchRest[P paginated](ctx context.Context, b Pager[P], first P, start, concurrency int, cfg iterConfig) ([]P, error) {
+	last := min(first.Pagination().TotalPages, maxPage)
+	if cfg.maxPages > 0 {
+		last = min(last, start+cfg.maxPages-1)
+	}
+	if last <= start {
+		return []P{first}, nil
+	}
+
+	concurrency = max(concurrency, 1)
+	pages := make([]P, last-start+1)
+	errs := make([]error, len(pages))
+	pages[0] = first
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for i := 1; i < len(pages); i++ {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			continue
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			page := start + i
+			pages[i], errs[i] = b.execPage(ctx, &page)
+		}(i)
+	}
+	wg.Wait()
+
+	fetched := make([]P, 0, len(pages))
+	var partial *PartialError
+	for i, err := range errs {
+		if err != nil {
+			if partial == nil {
+				partial = &PartialError{Pages: len(pages)}
+			}
+			partial.Failed = append(partial.Failed, FailedPage{Page: start + i, Err: err})
+			continue
+		}
+		fetched = append(fetched, pages[i])
+	}
+	if partial != nil {
+		return fetched, partial
+	}
+	return fetched, nil
+}
+
+// FetchAll is like Pages but returns the results of the pages, in page order.
+// Results appearing on several pages are only kept the first time, and MaxItems applies among opts.
+func FetchAll[R any, P ResultsPage[R]](ctx context.Context, b Pager[P], concurrency int, opts ...IterOption) ([]R, error) {
+	var cfg iterConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	pages, err := Pages(ctx, b, concurrency, opts...)
+	return results(pages, cfg.maxItems), err
+}
+
+// results returns the results of pages in order, skipping the ones seen on a previous page.
+// A positive maxItems limits the number of results.
+func results[R any, P ResultsPage[R]](pages []P, maxItems int) []R {
+	seen := make(map[any]struct{})
+	var out []R
+	for _, page := range pages {
+		for _, item := range page.Items() {
+			if maxItems > 0 && len(out) >= maxItems {
+				return out
+			}
+			if key, ok := resultKey(item); ok {
+				if _, dup := seen[key]; dup {
+					continue
+				}
+				seen[key] = struct{}{}
+			}
+			out = append(out, item)
+		}
+	}
+	return out
+}
@@ -0,0 +1,259 @@
+package options_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/falconer001/gotmdb"
+	"github.com/falconer001/gotmdb/client"
+	"github.com/falconer001/gotmdb/options"
+	"github.com/falconer001/gotmdb/tmdbtest"
+	"github.com/falconer001/gotmdb/types"
+)
+
+func newTMDB(t *testing.T, srv *tmdbtest.Server) *gotmdb.TMDBClient {
+	t.Helper()
+	tmdb, err := gotmdb.New(srv.Config())
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	return tmdb
+}
+
+// collect runs the iteration over the popular movies, returning the IDs of the results and the error yielded.
+func collect(t *testing.T, b *options.PagedBuilder[*types.MoviePaginatedResults], opts ...options.IterOption) ([]int, error) {
+	t.Helper()
+	var ids []int
+	for movie, err := range options.All(context.Background(), b, opts...) {
+		if err != nil {
+			return ids, err
+		}
+		ids = append(ids, movie.ID)
+	}
+	return ids, nil
+}
+
+// pageRequests counts the requests for path.
+func pageRequests(srv *tmdbtest.Server, path string) int {
+	n := 0
+	for _, r := range srv.Requests() {
+		if r.Path == path {
+			n++
+		}
+	}
+	return n
+}
+
+// servePages makes GET /movie/popular serve the IDs of pages, failing the pages listed in failing.
+func servePages(srv *tmdbtest.Server, pages [][]int, failing ...int) {
+	srv.Handle("GET /movie/popular", func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		for _, p := range failing {
+			if p == page {
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte(`{"status_code": 11, "status_message": "Internal error: Something went wrong, contact TMDb."}`))
+				return
+			}
+		}
+		results := []map[string]any{}
+		if page >= 1 && page <= len(pages) {
+			for _, id := range pages[page-1] {
+				results = append(results, map[string]any{"id": id, "title": "Movie " + strconv.Itoa(id)})
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"page":          page,
+			"results":       results,
+			"total_pages":   len(pages),
+			"total_results": 0,
+		})
+	})
+}
+
+func TestAll(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	tmdb := newTMDB(t, srv)
+
+	srv.SetTotalResults("GET /movie/popular", 45)
+	ids, err := collect(t, tmdb.Movies.GetPopular())
+	if err != nil {
+		t.Fatalf("All: %v", err)
+	}
+	if len(ids) != 45 || ids[0] != 1 || ids[44] != 45 {
+		t.Errorf("got %d results from %v to %v, want 1 to 45", len(ids), ids[0], ids[len(ids)-1])
+	}
+	if n := pageRequests(srv, "/movie/popular"); n != 3 {
+		t.Errorf("fetched %d pages, want 3", n)
+	}
+}
+
+func TestAllLimits(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	tmdb := newTMDB(t, srv)
+
+	ids, err := collect(t, tmdb.Movies.GetPopular(), options.MaxItems(25))
+	if err != nil {
+		t.Fatalf("MaxItems: %v", err)
+	}
+	if len(ids) != 25 || pageRequests(srv, "/movie/popular") != 2 {
+		t.Errorf("MaxItems(25) got %d results in %d pages, want 25 in 2", len(ids), pageRequests(srv, "/movie/popular"))
+	}
+
+	srv.Reset()
+	ids, err = collect(t, tmdb.Movies.GetPopular().Page(2), options.MaxPages(2))
+	if err != nil {
+		t.Fatalf("MaxPages: %v", err)
+	}
+	if len(ids) != 40 || ids[0] != 21 {
+		t.Errorf("MaxPages(2) from page 2 got %d results starting at %d, want 40 starting at 21", len(ids), ids[0])
+	}
+}
+
+func TestAllStopsAtLastPage(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	tmdb := newTMDB(t, srv)
+
+	// Stopping the loop doesn't fetch more pages
+	for range options.All(context.Background(), tmdb.Movies.GetPopular()) {
+		break
+	}
+	if n := pageRequests(srv, "/movie/popular"); n != 1 {
+		t.Errorf("fetched %d pages after a break, want 1", n)
+	}
+
+	srv.Reset()
+	srv.SetTotalResults("GET /movie/popular", 0)
+	ids, err := collect(t, tmdb.Movies.GetPopular())
+	if err != nil || len(ids) != 0 {
+		t.Errorf("empty list got %v, %v, want nothing", ids, err)
+	}
+}
+
+func TestAllSkipsShiftedResults(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	tmdb := newTMDB(t, srv)
+
+	// 3 moved down from page 1 to page 2 while iterating
+	servePages(srv, [][]int{{1, 2, 3}, {3, 4, 5}})
+	ids, err := collect(t, tmdb.Movies.GetPopular())
+	if err != nil {
+		t.Fatalf("All: %v", err)
+	}
+	if want := []int{1, 2, 3, 4, 5}; !slices.Equal(ids, want) {
+		t.Errorf("got %v, want %v", ids, want)
+	}
+}
+
+func TestAllError(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	tmdb := newTMDB(t, srv)
+
+	servePages(srv, [][]int{{1, 2}, {3, 4}, {5, 6}}, 2)
+	ids, err := collect(t, tmdb.Movies.GetPopular())
+	if !errors.Is(err, client.ErrServiceUnavailable) {
+		t.Fatalf("err = %v, want ErrServiceUnavailable", err)
+	}
+	if want := []int{1, 2}; !slices.Equal(ids, want) {
+		t.Errorf("got %v before the error, want %v", ids, want)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for _, err := range options.All(ctx, tmdb.Movies.GetPopular()) {
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("canceled context: err = %v, want context.Canceled", err)
+		}
+	}
+}
+
+func TestSearchAll(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	tmdb := newTMDB(t, srv)
+
+	srv.SetTotalResults("GET /search/movie", 30)
+	n := 0
+	for movie, err := range tmdb.Search.Movies("fight club").All(context.Background()) {
+		if err != nil {
+			t.Fatalf("All: %v", err)
+		}
+		n++
+		if movie.ID != n {
+			t.Fatalf("result %d has ID %d", n, movie.ID)
+		}
+	}
+	if n != 30 {
+		t.Errorf("got %d results, want 30", n)
+	}
+	for _, r := range srv.Requests() {
+		if q := r.Query.Get("query"); q != "fight club" {
+			t.Errorf("page %s sent query %q", r.Query.Get("page"), q)
+		}
+	}
+}
+
+func TestPages(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	tmdb := newTMDB(t, srv)
+
+	srv.SetTotalResults("GET /movie/popular", 90)
+	pages, err := options.Pages(context.Background(), tmdb.Movies.GetPopular(), 3)
+	if err != nil {
+		t.Fatalf("Pages: %v", err)
+	}
+	if len(pages) != 5 {
+		t.Fatalf("got %d pages, want 5", len(pages))
+	}
+	for i, p := range pages {
+		if p.Page != i+1 {
+			t.Errorf("pages[%d] is page %d", i, p.Page)
+		}
+	}
+
+	movies, err := options.FetchAll(context.Background(), tmdb.Movies.GetPopular(), 3, options.MaxItems(50))
+	if err != nil {
+		t.Fatalf("FetchAll: %v", err)
+	}
+	if len(movies) != 50 || movies[49].ID != 50 {
+		t.Errorf("got %d movies, want 50 in order", len(movies))
+	}
+}
+
+func TestPagesPartial(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	tmdb := newTMDB(t, srv)
+
+	servePages(srv, [][]int{{1}, {2}, {3}, {4}}, 3)
+	pages, err := options.Pages(context.Background(), tmdb.Movies.GetPopular(), 2)
+	var partial *options.PartialError
+	if !errors.As(err, &partial) {
+		t.Fatalf("err = %v, want a *PartialError", err)
+	}
+	if partial.Pages != 4 || len(partial.Failed) != 1 || partial.Failed[0].Page != 3 {
+		t.Errorf("PartialError = %+v, want page 3 of 4 failed", partial)
+	}
+	if !errors.Is(err, client.ErrServiceUnavailable) {
+		t.Errorf("err = %v, want it to match ErrServiceUnavailable", err)
+	}
+	if len(pages) != 3 || pages[2].Page != 4 {
+		t.Errorf("got %d pages, want pages 1, 2 and 4", len(pages))
+	}
+
+	// A failed first page is returned as is
+	servePages(srv, [][]int{{1}, {2}}, 1)
+	if _, err := options.Pages(context.Background(), tmdb.Movies.GetPopular(), 2); errors.As(err, &partial) || err == nil {
+		t.Errorf("failed first page: err = %v, want the page's error", err)
+	}
+}
@@ -1,11 +1,11 @@
 package options
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/falconer001/gotmdb/client"
 	"github.com/falconer001/gotmdb/types"
-	"github.com/falconer001/gotmdb/utils"
 )
 
 type StateSessionBuilder[T any] struct {
@@ -21,7 +21,12 @@ type StateSessionBuilder[T any] struct {
 }
 
 type allowedStateSessionT interface {
-	*types.StatusResponse | *types.AccountState
+	*types.StatusResponse |
+		*types.AccountState |
+		*types.AccountDetails |
+		*types.CreateListResponse |
+		*types.TVSeasonAccountStatesResponse |
+		*types.EpisodeAccountStateSingle
 }
 
 func NewStateSessionBuilder[T allowedStateSessionT](c *client.Client, path string, method string, body any) *StateSessionBuilder[T] {
@@ -55,6 +60,11 @@ func (b *StateSessionBuilder[T]) GuestSessionID(id string) *StateSessionBuilder[
 
 // Exec performs the request and returns the response.
 func (b *StateSessionBuilder[T]) Exec() (T, error) {
+	return b.ExecContext(context.Background())
+}
+
+// ExecContext is like Exec but uses ctx for the request.
+func (b *StateSessionBuilder[T]) ExecContext(ctx context.Context) (T, error) {
 	var zero T
 
 	if b.opts.ForGuest {
@@ -63,15 +73,5 @@ func (b *StateSessionBuilder[T]) Exec() (T, error) {
 		}
 	}
 
-	resp := new(T)
-	params, err := utils.StructToURLValues(b.opts)
-	if err != nil {
-		return zero, fmt.Errorf("failed to convert options: %w", err)
-	}
-
-	err = b.client.DoRequest(b.method, b.path, params, b.body, resp)
-	if err != nil {
-		return zero, err
-	}
-	return *resp, nil
+	return sendPrivate[T](ctx, b.client, b.method, b.path, b.body, b.opts)
 }
@@ -0,0 +1,67 @@
+package options
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/falconer001/gotmdb/client"
+	"github.com/falconer001/gotmdb/utils"
+)
+
+// request is embedded in the builders of cacheable GET requests.
+// It holds the client, the path and the cache mode, and gives the builder B its NoCache and Refresh methods.
+type request[B any] struct {
+	self   B
+	client *client.Client
+	path   string
+	cache  client.CacheMode
+}
+
+func newRequest[B any](self B, c *client.Client, path string) request[B] {
+	return request[B]{self: self, client: c, path: path}
+}
+
+// NoCache makes the request skip the response cache entirely.
+func (r *request[B]) NoCache() B {
+	r.cache = client.CacheBypass
+	return r.self
+}
+
+// Refresh ignores any cached response but caches the fresh one.
+func (r *request[B]) Refresh() B {
+	r.cache = client.CacheRefresh
+	return r.self
+}
+
+// get performs a GET request on r's path with the builder's cache mode, and decodes the response into a T.
+func get[T, B any](ctx context.Context, r *request[B], opts ...any) (T, error) {
+	return send[T](client.WithCacheMode(ctx, r.cache), r.client, "GET", r.path, nil, opts...)
+}
+
+// sendPrivate is like send for the requests reading or changing a user's data: sessions, account states,
+// ratings, lists and watchlists. Their responses are never cached, whatever the cache rules and the caller's cache mode.
+func sendPrivate[T any](ctx context.Context, c *client.Client, method, path string, body any, opts ...any) (T, error) {
+	return send[T](client.WithCacheMode(ctx, client.CacheBypass), c, method, path, body, opts...)
+}
+
+// send performs a request with the fields of opts as query parameters, and decodes the response into a T.
+func send[T any](ctx context.Context, c *client.Client, method, path string, body any, opts ...any) (T, error) {
+	var zero T
+	params := url.Values{}
+	for _, o := range opts {
+		values, err := utils.StructToURLValues(o)
+		if err != nil {
+			return zero, fmt.Errorf("failed to convert options: %w", err)
+		}
+		for k, v := range values {
+			params[k] = append(params[k], v...)
+		}
+	}
+
+	resp := new(T)
+	if err := c.DoRequestContext(ctx, method, path, params, body, resp); err != nil {
+		return zero, err
+	}
+	return *resp, nil
+}
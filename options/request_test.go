@@ -0,0 +1,71 @@
+package options_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/falconer001/gotmdb"
+	"github.com/falconer001/gotmdb/client"
+	"github.com/falconer001/gotmdb/tmdbtest"
+)
+
+func newCachingTMDB(t *testing.T, srv *tmdbtest.Server) *gotmdb.TMDBClient {
+	t.Helper()
+	config := srv.Config()
+	config.Cache = client.NewLRUCache(100)
+	config.CacheTTL = time.Hour
+	tmdb, err := gotmdb.New(config)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	return tmdb
+}
+
+func TestBuilderCacheModes(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	tmdb := newCachingTMDB(t, srv)
+	ctx := context.Background()
+
+	steps := []struct {
+		name string
+		exec func() error
+		sent int
+	}{
+		{"first", func() error { _, err := tmdb.Movies.GetDetails(550).ExecContext(ctx); return err }, 1},
+		{"cached", func() error { _, err := tmdb.Movies.GetDetails(550).ExecContext(ctx); return err }, 1},
+		{"NoCache", func() error { _, err := tmdb.Movies.GetDetails(550).NoCache().ExecContext(ctx); return err }, 2},
+		{"Refresh", func() error { _, err := tmdb.Movies.GetDetails(550).Refresh().ExecContext(ctx); return err }, 3},
+		{"after Refresh", func() error { _, err := tmdb.Movies.GetDetails(550).ExecContext(ctx); return err }, 3},
+		{"discover", func() error { _, err := tmdb.Discover.DiscoverMovies().Page(2).ExecContext(ctx); return err }, 4},
+		{"discover cached", func() error { _, err := tmdb.Discover.DiscoverMovies().Page(2).ExecContext(ctx); return err }, 4},
+		{"discover NoCache", func() error { _, err := tmdb.Discover.DiscoverMovies().Page(2).NoCache().ExecContext(ctx); return err }, 5},
+	}
+	for _, step := range steps {
+		if err := step.exec(); err != nil {
+			t.Fatalf("%s: %v", step.name, err)
+		}
+		if n := len(srv.Requests()); n != step.sent {
+			t.Errorf("%s: sent %d requests, want %d", step.name, n, step.sent)
+		}
+	}
+}
+
+func TestPrivateRequestsNeverCached(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	tmdb := newCachingTMDB(t, srv)
+
+	// Account lists read with the bearer token carry no session_id, they still bypass the cache
+	srv.Respond("GET /account/{id}/favorite/movies", http.StatusOK, map[string]any{"page": 1, "results": []any{}, "total_pages": 1})
+	for range 2 {
+		if _, err := tmdb.Account.GetFavoriteMovies(42).Exec(); err != nil {
+			t.Fatalf("GetFavoriteMovies: %v", err)
+		}
+	}
+	if n := len(srv.Requests()); n != 2 {
+		t.Errorf("sent %d requests, want 2", n)
+	}
+}
@@ -1,13 +1,12 @@
 package options
 
 import (
-	"fmt"
-	"log"
+	"context"
+	"iter"
 	"slices"
 
 	"github.com/falconer001/gotmdb/client"
 	"github.com/falconer001/gotmdb/types"
-	"github.com/falconer001/gotmdb/utils"
 )
 
 // --- Search Movies --- //
@@ -23,15 +22,14 @@ type searchMoviesOptions struct {
 }
 
 type SearchMoviesBuilder struct {
-	client *client.Client
-	opts   searchMoviesOptions
+	request[*SearchMoviesBuilder]
+	opts searchMoviesOptions
 }
 
 func NewSearchMoviesBuilder(c *client.Client, query string) *SearchMoviesBuilder {
-	return &SearchMoviesBuilder{
-		client: c,
-		opts:   searchMoviesOptions{Query: query},
-	}
+	b := &SearchMoviesBuilder{opts: searchMoviesOptions{Query: query}}
+	b.request = newRequest(b, c, "/search/movie")
+	return b
 }
 
 func (b *SearchMoviesBuilder) IncludeAdult(include bool) *SearchMoviesBuilder {
@@ -65,17 +63,41 @@ func (b *SearchMoviesBuilder) Year(year int) *SearchMoviesBuilder {
 }
 
 func (b *SearchMoviesBuilder) Exec() (*types.MoviePaginatedResults, error) {
-	path := "/search/movie"
-	resp := new(types.MoviePaginatedResults)
-	params, err := utils.StructToURLValues(b.opts)
-	if err != nil {
-		return nil, fmt.Errorf("failed to convert options: %w", err)
-	}
-	err = b.client.DoRequest("GET", path, params, nil, resp)
-	if err != nil {
-		return nil, err
-	}
-	return resp, nil
+	return b.ExecContext(context.Background())
+}
+
+// ExecContext is like Exec but uses ctx for the request.
+func (b *SearchMoviesBuilder) ExecContext(ctx context.Context) (*types.MoviePaginatedResults, error) {
+	return b.execPage(ctx, b.opts.Page)
+}
+
+// All returns an iterator over the results of every page, starting at the page set on the builder.
+// See the All function for details.
+func (b *SearchMoviesBuilder) All(ctx context.Context, opts ...IterOption) iter.Seq2[types.MovieListResult, error] {
+	return All(ctx, b, opts...)
+}
+
+// Pages fetches every page concurrently and returns them in page order.
+// See the Pages function for details.
+func (b *SearchMoviesBuilder) Pages(ctx context.Context, concurrency int, opts ...IterOption) ([]*types.MoviePaginatedResults, error) {
+	return Pages(ctx, b, concurrency, opts...)
+}
+
+// FetchAll fetches every page concurrently and returns their results in page order.
+// See the FetchAll function for details.
+func (b *SearchMoviesBuilder) FetchAll(ctx context.Context, concurrency int, opts ...IterOption) ([]types.MovieListResult, error) {
+	return FetchAll(ctx, b, concurrency, opts...)
+}
+
+func (b *SearchMoviesBuilder) startPage() int {
+	return pageOrFirst(b.opts.Page)
+}
+
+// execPage performs the request for page, or without a page parameter if nil.
+func (b *SearchMoviesBuilder) execPage(ctx context.Context, page *int) (*types.MoviePaginatedResults, error) {
+	opts := b.opts
+	opts.Page = page
+	return get[*types.MoviePaginatedResults](ctx, &b.request, opts)
 }
 
 // --- Search TV --- //
@@ -90,15 +112,14 @@ type searchTVOptions struct {
 }
 
 type SearchTVBuilder struct {
-	client *client.Client
-	opts   searchTVOptions
+	request[*SearchTVBuilder]
+	opts searchTVOptions
 }
 
 func NewSearchTVBuilder(c *client.Client, query string) *SearchTVBuilder {
-	return &SearchTVBuilder{
-		client: c,
-		opts:   searchTVOptions{Query: query},
-	}
+	b := &SearchTVBuilder{opts: searchTVOptions{Query: query}}
+	b.request = newRequest(b, c, "/search/tv")
+	return b
 }
 
 func (b *SearchTVBuilder) IncludeAdult(include bool) *SearchTVBuilder {
@@ -128,17 +149,41 @@ func (b *SearchTVBuilder) Year(year int) *SearchTVBuilder {
 }
 
 func (b *SearchTVBuilder) Exec() (*types.TVShowPaginatedResults, error) {
-	path := "/search/tv"
-	resp := new(types.TVShowPaginatedResults)
-	params, err := utils.StructToURLValues(b.opts)
-	if err != nil {
-		return nil, fmt.Errorf("failed to convert options: %w", err)
-	}
-	err = b.client.DoRequest("GET", path, params, nil, resp)
-	if err != nil {
-		return nil, err
-	}
-	return resp, nil
+	return b.ExecContext(context.Background())
+}
+
+// ExecContext is like Exec but uses ctx for the request.
+func (b *SearchTVBuilder) ExecContext(ctx context.Context) (*types.TVShowPaginatedResults, error) {
+	return b.execPage(ctx, b.opts.Page)
+}
+
+// All returns an iterator over the results of every page, starting at the page set on the builder.
+// See the All function for details.
+func (b *SearchTVBuilder) All(ctx context.Context, opts ...IterOption) iter.Seq2[types.TVListResult, error] {
+	return All(ctx, b, opts...)
+}
+
+// Pages fetches every page concurrently and returns them in page order.
+// See the Pages function for details.
+func (b *SearchTVBuilder) Pages(ctx context.Context, concurrency int, opts ...IterOption) ([]*types.TVShowPaginatedResults, error) {
+	return Pages(ctx, b, concurrency, opts...)
+}
+
+// FetchAll fetches every page concurrently and returns their results in page order.
+// See the FetchAll function for details.
+func (b *SearchTVBuilder) FetchAll(ctx context.Context, concurrency int, opts ...IterOption) ([]types.TVListResult, error) {
+	return FetchAll(ctx, b, concurrency, opts...)
+}
+
+func (b *SearchTVBuilder) startPage() int {
+	return pageOrFirst(b.opts.Page)
+}
+
+// execPage performs the request for page, or without a page parameter if nil.
+func (b *SearchTVBuilder) execPage(ctx context.Context, page *int) (*types.TVShowPaginatedResults, error) {
+	opts := b.opts
+	opts.Page = page
+	return get[*types.TVShowPaginatedResults](ctx, &b.request, opts)
 }
 
 // --- Search Multi --- //
@@ -152,15 +197,14 @@ type searchMultiOptions struct {
 }
 
 type SearchMultiBuilder struct {
-	client *client.Client
-	opts   searchMultiOptions
+	request[*SearchMultiBuilder]
+	opts searchMultiOptions
 }
 
 func NewSearchMultiBuilder(c *client.Client, query string) *SearchMultiBuilder {
-	return &SearchMultiBuilder{
-		client: c,
-		opts:   searchMultiOptions{Query: query},
-	}
+	b := &SearchMultiBuilder{opts: searchMultiOptions{Query: query}}
+	b.request = newRequest(b, c, "/search/multi")
+	return b
 }
 
 func (b *SearchMultiBuilder) IncludePeople(include bool) *SearchMultiBuilder {
@@ -186,13 +230,41 @@ func (b *SearchMultiBuilder) Page(page int) *SearchMultiBuilder {
 // Exec performs the search multi request and returns the response.
 // If IncludePeople is false, person results are removed from the response (this is the default mode).
 func (b *SearchMultiBuilder) Exec() (*types.SearchMultiResponse, error) {
-	path := "/search/multi"
-	resp := new(types.SearchMultiResponse)
-	params, err := utils.StructToURLValues(b.opts)
-	if err != nil {
-		return nil, fmt.Errorf("failed to convert options: %w", err)
-	}
-	err = b.client.DoRequest("GET", path, params, nil, resp)
+	return b.ExecContext(context.Background())
+}
+
+// ExecContext is like Exec but uses ctx for the request.
+func (b *SearchMultiBuilder) ExecContext(ctx context.Context) (*types.SearchMultiResponse, error) {
+	return b.execPage(ctx, b.opts.Page)
+}
+
+// All returns an iterator over the results of every page, starting at the page set on the builder.
+// See the All function for details.
+func (b *SearchMultiBuilder) All(ctx context.Context, opts ...IterOption) iter.Seq2[types.SearchMultiResult, error] {
+	return All(ctx, b, opts...)
+}
+
+// Pages fetches every page concurrently and returns them in page order.
+// See the Pages function for details.
+func (b *SearchMultiBuilder) Pages(ctx context.Context, concurrency int, opts ...IterOption) ([]*types.SearchMultiResponse, error) {
+	return Pages(ctx, b, concurrency, opts...)
+}
+
+// FetchAll fetches every page concurrently and returns their results in page order.
+// See the FetchAll function for details.
+func (b *SearchMultiBuilder) FetchAll(ctx context.Context, concurrency int, opts ...IterOption) ([]types.SearchMultiResult, error) {
+	return FetchAll(ctx, b, concurrency, opts...)
+}
+
+func (b *SearchMultiBuilder) startPage() int {
+	return pageOrFirst(b.opts.Page)
+}
+
+// execPage performs the request for page, or without a page parameter if nil.
+func (b *SearchMultiBuilder) execPage(ctx context.Context, page *int) (*types.SearchMultiResponse, error) {
+	opts := b.opts
+	opts.Page = page
+	resp, err := get[*types.SearchMultiResponse](ctx, &b.request, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -205,7 +277,7 @@ func (b *SearchMultiBuilder) Exec() (*types.SearchMultiResponse, error) {
 				totalRemoved++
 			}
 		}
-		log.Printf("tmdb: Removed %d person results\n", totalRemoved)
+		b.client.Logger().DebugContext(ctx, "tmdb: removed person results", "count", totalRemoved)
 	}
 
 	return resp, nil
@@ -219,15 +291,14 @@ type searchCompaniesOptions struct {
 }
 
 type SearchCompaniesBuilder struct {
-	client *client.Client
-	opts   searchCompaniesOptions
+	request[*SearchCompaniesBuilder]
+	opts searchCompaniesOptions
 }
 
 func NewSearchCompaniesBuilder(c *client.Client, query string) *SearchCompaniesBuilder {
-	return &SearchCompaniesBuilder{
-		client: c,
-		opts:   searchCompaniesOptions{Query: query},
-	}
+	b := &SearchCompaniesBuilder{opts: searchCompaniesOptions{Query: query}}
+	b.request = newRequest(b, c, "/search/company")
+	return b
 }
 
 func (b *SearchCompaniesBuilder) Page(page int) *SearchCompaniesBuilder {
@@ -236,17 +307,41 @@ func (b *SearchCompaniesBuilder) Page(page int) *SearchCompaniesBuilder {
 }
 
 func (b *SearchCompaniesBuilder) Exec() (*types.CompanySearchResponse, error) {
-	path := "/search/company"
-	resp := new(types.CompanySearchResponse)
-	params, err := utils.StructToURLValues(b.opts)
-	if err != nil {
-		return nil, fmt.Errorf("failed to convert options: %w", err)
-	}
-	err = b.client.DoRequest("GET", path, params, nil, resp)
-	if err != nil {
-		return nil, err
-	}
-	return resp, nil
+	return b.ExecContext(context.Background())
+}
+
+// ExecContext is like Exec but uses ctx for the request.
+func (b *SearchCompaniesBuilder) ExecContext(ctx context.Context) (*types.CompanySearchResponse, error) {
+	return b.execPage(ctx, b.opts.Page)
+}
+
+// All returns an iterator over the results of every page, starting at the page set on the builder.
+// See the All function for details.
+func (b *SearchCompaniesBuilder) All(ctx context.Context, opts ...IterOption) iter.Seq2[types.CompanySearchResult, error] {
+	return All(ctx, b, opts...)
+}
+
+// Pages fetches every page concurrently and returns them in page order.
+// See the Pages function for details.
+func (b *SearchCompaniesBuilder) Pages(ctx context.Context, concurrency int, opts ...IterOption) ([]*types.CompanySearchResponse, error) {
+	return Pages(ctx, b, concurrency, opts...)
+}
+
+// FetchAll fetches every page concurrently and returns their results in page order.
+// See the FetchAll function for details.
+func (b *SearchCompaniesBuilder) FetchAll(ctx context.Context, concurrency int, opts ...IterOption) ([]types.CompanySearchResult, error) {
+	return FetchAll(ctx, b, concurrency, opts...)
+}
+
+func (b *SearchCompaniesBuilder) startPage() int {
+	return pageOrFirst(b.opts.Page)
+}
+
+// execPage performs the request for page, or without a page parameter if nil.
+func (b *SearchCompaniesBuilder) execPage(ctx context.Context, page *int) (*types.CompanySearchResponse, error) {
+	opts := b.opts
+	opts.Page = page
+	return get[*types.CompanySearchResponse](ctx, &b.request, opts)
 }
 
 // --- Search Collections --- //
@@ -259,15 +354,14 @@ type searchCollectionsOptions struct {
 }
 
 type SearchCollectionsBuilder struct {
-	client *client.Client
-	opts   searchCollectionsOptions
+	request[*SearchCollectionsBuilder]
+	opts searchCollectionsOptions
 }
 
 func NewSearchCollectionsBuilder(c *client.Client, query string) *SearchCollectionsBuilder {
-	return &SearchCollectionsBuilder{
-		client: c,
-		opts:   searchCollectionsOptions{Query: query},
-	}
+	b := &SearchCollectionsBuilder{opts: searchCollectionsOptions{Query: query}}
+	b.request = newRequest(b, c, "/search/collection")
+	return b
 }
 
 func (b *SearchCollectionsBuilder) IncludeAdult(include bool) *SearchCollectionsBuilder {
@@ -286,17 +380,41 @@ func (b *SearchCollectionsBuilder) Page(page int) *SearchCollectionsBuilder {
 }
 
 func (b *SearchCollectionsBuilder) Exec() (*types.CollectionSearchResponse, error) {
-	path := "/search/collection"
-	resp := new(types.CollectionSearchResponse)
-	params, err := utils.StructToURLValues(b.opts)
-	if err != nil {
-		return nil, fmt.Errorf("failed to convert options: %w", err)
-	}
-	err = b.client.DoRequest("GET", path, params, nil, resp)
-	if err != nil {
-		return nil, err
-	}
-	return resp, nil
+	return b.ExecContext(context.Background())
+}
+
+// ExecContext is like Exec but uses ctx for the request.
+func (b *SearchCollectionsBuilder) ExecContext(ctx context.Context) (*types.CollectionSearchResponse, error) {
+	return b.execPage(ctx, b.opts.Page)
+}
+
+// All returns an iterator over the results of every page, starting at the page set on the builder.
+// See the All function for details.
+func (b *SearchCollectionsBuilder) All(ctx context.Context, opts ...IterOption) iter.Seq2[types.CollectionSearchResult, error] {
+	return All(ctx, b, opts...)
+}
+
+// Pages fetches every page concurrently and returns them in page order.
+// See the Pages function for details.
+func (b *SearchCollectionsBuilder) Pages(ctx context.Context, concurrency int, opts ...IterOption) ([]*types.CollectionSearchResponse, error) {
+	return Pages(ctx, b, concurrency, opts...)
+}
+
+// FetchAll fetches every page concurrently and returns their results in page order.
+// See the FetchAll function for details.
+func (b *SearchCollectionsBuilder) FetchAll(ctx context.Context, concurrency int, opts ...IterOption) ([]types.CollectionSearchResult, error) {
+	return FetchAll(ctx, b, concurrency, opts...)
+}
+
+func (b *SearchCollectionsBuilder) startPage() int {
+	return pageOrFirst(b.opts.Page)
+}
+
+// execPage performs the request for page, or without a page parameter if nil.
+func (b *SearchCollectionsBuilder) execPage(ctx context.Context, page *int) (*types.CollectionSearchResponse, error) {
+	opts := b.opts
+	opts.Page = page
+	return get[*types.CollectionSearchResponse](ctx, &b.request, opts)
 }
 
 // --- Search Keywords --- //
@@ -307,15 +425,14 @@ type searchKeywordsOptions struct {
 }
 
 type SearchKeywordsBuilder struct {
-	client *client.Client
-	opts   searchKeywordsOptions
+	request[*SearchKeywordsBuilder]
+	opts searchKeywordsOptions
 }
 
 func NewSearchKeywordsBuilder(c *client.Client, query string) *SearchKeywordsBuilder {
-	return &SearchKeywordsBuilder{
-		client: c,
-		opts:   searchKeywordsOptions{Query: query},
-	}
+	b := &SearchKeywordsBuilder{opts: searchKeywordsOptions{Query: query}}
+	b.request = newRequest(b, c, "/search/keyword")
+	return b
 }
 
 func (b *SearchKeywordsBuilder) Page(page int) *SearchKeywordsBuilder {
@@ -324,17 +441,41 @@ func (b *SearchKeywordsBuilder) Page(page int) *SearchKeywordsBuilder {
 }
 
 func (b *SearchKeywordsBuilder) Exec() (*types.KeywordSearchResponse, error) {
-	path := "/search/keyword"
-	resp := new(types.KeywordSearchResponse)
-	params, err := utils.StructToURLValues(b.opts)
-	if err != nil {
-		return nil, fmt.Errorf("failed to convert options: %w", err)
-	}
-	err = b.client.DoRequest("GET", path, params, nil, resp)
-	if err != nil {
-		return nil, err
-	}
-	return resp, nil
+	return b.ExecContext(context.Background())
+}
+
+// ExecContext is like Exec but uses ctx for the request.
+func (b *SearchKeywordsBuilder) ExecContext(ctx context.Context) (*types.KeywordSearchResponse, error) {
+	return b.execPage(ctx, b.opts.Page)
+}
+
+// All returns an iterator over the results of every page, starting at the page set on the builder.
+// See the All function for details.
+func (b *SearchKeywordsBuilder) All(ctx context.Context, opts ...IterOption) iter.Seq2[types.Keyword, error] {
+	return All(ctx, b, opts...)
+}
+
+// Pages fetches every page concurrently and returns them in page order.
+// See the Pages function for details.
+func (b *SearchKeywordsBuilder) Pages(ctx context.Context, concurrency int, opts ...IterOption) ([]*types.KeywordSearchResponse, error) {
+	return Pages(ctx, b, concurrency, opts...)
+}
+
+// FetchAll fetches every page concurrently and returns their results in page order.
+// See the FetchAll function for details.
+func (b *SearchKeywordsBuilder) FetchAll(ctx context.Context, concurrency int, opts ...IterOption) ([]types.Keyword, error) {
+	return FetchAll(ctx, b, concurrency, opts...)
+}
+
+func (b *SearchKeywordsBuilder) startPage() int {
+	return pageOrFirst(b.opts.Page)
+}
+
+// execPage performs the request for page, or without a page parameter if nil.
+func (b *SearchKeywordsBuilder) execPage(ctx context.Context, page *int) (*types.KeywordSearchResponse, error) {
+	opts := b.opts
+	opts.Page = page
+	return get[*types.KeywordSearchResponse](ctx, &b.request, opts)
 }
 
 // --- Search People --- //
@@ -347,15 +488,14 @@ type searchPeopleOptions struct {
 }
 
 type SearchPeopleBuilder struct {
-	client *client.Client
-	opts   searchPeopleOptions
+	request[*SearchPeopleBuilder]
+	opts searchPeopleOptions
 }
 
 func NewSearchPeopleBuilder(c *client.Client, query string) *SearchPeopleBuilder {
-	return &SearchPeopleBuilder{
-		client: c,
-		opts:   searchPeopleOptions{Query: query},
-	}
+	b := &SearchPeopleBuilder{opts: searchPeopleOptions{Query: query}}
+	b.request = newRequest(b, c, "/search/person")
+	return b
 }
 
 func (b *SearchPeopleBuilder) IncludeAdult(include bool) *SearchPeopleBuilder {
@@ -374,15 +514,39 @@ func (b *SearchPeopleBuilder) Page(page int) *SearchPeopleBuilder {
 }
 
 func (b *SearchPeopleBuilder) Exec() (*types.PersonPaginatedResults, error) {
-	path := "/search/person"
-	resp := new(types.PersonPaginatedResults)
-	params, err := utils.StructToURLValues(b.opts)
-	if err != nil {
-		return nil, fmt.Errorf("failed to convert options: %w", err)
-	}
-	err = b.client.DoRequest("GET", path, params, nil, resp)
-	if err != nil {
-		return nil, err
-	}
-	return resp, nil
+	return b.ExecContext(context.Background())
+}
+
+// ExecContext is like Exec but uses ctx for the request.
+func (b *SearchPeopleBuilder) ExecContext(ctx context.Context) (*types.PersonPaginatedResults, error) {
+	return b.execPage(ctx, b.opts.Page)
+}
+
+// All returns an iterator over the results of every page, starting at the page set on the builder.
+// See the All function for details.
+func (b *SearchPeopleBuilder) All(ctx context.Context, opts ...IterOption) iter.Seq2[types.PersonListResult, error] {
+	return All(ctx, b, opts...)
+}
+
+// Pages fetches every page concurrently and returns them in page order.
+// See the Pages function for details.
+func (b *SearchPeopleBuilder) Pages(ctx context.Context, concurrency int, opts ...IterOption) ([]*types.PersonPaginatedResults, error) {
+	return Pages(ctx, b, concurrency, opts...)
+}
+
+// FetchAll fetches every page concurrently and returns their results in page order.
+// See the FetchAll function for details.
+func (b *SearchPeopleBuilder) FetchAll(ctx context.Context, concurrency int, opts ...IterOption) ([]types.PersonListResult, error) {
+	return FetchAll(ctx, b, concurrency, opts...)
+}
+
+func (b *SearchPeopleBuilder) startPage() int {
+	return pageOrFirst(b.opts.Page)
+}
+
+// execPage performs the request for page, or without a page parameter if nil.
+func (b *SearchPeopleBuilder) execPage(ctx context.Context, page *int) (*types.PersonPaginatedResults, error) {
+	opts := b.opts
+	opts.Page = page
+	return get[*types.PersonPaginatedResults](ctx, &b.request, opts)
 }
@@ -0,0 +1,46 @@
+package options
+
+import (
+	"context"
+
+	"github.com/falconer001/gotmdb/client"
+	"github.com/falconer001/gotmdb/types"
+)
+
+// *WATCH PROVIDER LIST BUILDER
+// WatchProviderListBuilder For the /watch/providers/movie and /watch/providers/tv endpoints
+type WatchProviderListBuilder struct {
+	request[*WatchProviderListBuilder]
+	opts struct {
+		Language    *string `url:"language,omitempty"`
+		WatchRegion *string `url:"watch_region,omitempty"`
+	}
+}
+
+func NewWatchProviderListBuilder(c *client.Client, path string) *WatchProviderListBuilder {
+	b := &WatchProviderListBuilder{}
+	b.request = newRequest(b, c, path)
+	return b
+}
+
+// Language sets the language parameter. e.g. "en-US", "fr-FR"
+func (b *WatchProviderListBuilder) Language(lang string) *WatchProviderListBuilder {
+	b.opts.Language = &lang
+	return b
+}
+
+// WatchRegion sets the watch_region parameter, limiting the list to the providers of a region. e.g. "US", "FR"
+func (b *WatchProviderListBuilder) WatchRegion(region string) *WatchProviderListBuilder {
+	b.opts.WatchRegion = &region
+	return b
+}
+
+// Exec performs the request and returns the response.
+func (b *WatchProviderListBuilder) Exec() (*types.WatchProviderListResponse, error) {
+	return b.ExecContext(context.Background())
+}
+
+// ExecContext is like Exec but uses ctx for the request.
+func (b *WatchProviderListBuilder) ExecContext(ctx context.Context) (*types.WatchProviderListResponse, error) {
+	return get[*types.WatchProviderListResponse](ctx, &b.request, b.opts)
+}
@@ -0,0 +1,140 @@
+// Package tmdbotel instruments the TMDb client with OpenTelemetry tracing and metrics.
+//
+// Add its middleware to the client config:
+//
+//	tmdb, err := gotmdb.New(gotmdb.Config{
+//		APIKey:      os.Getenv("TMDB_API_KEY"),
+//		Middlewares: []client.Middleware{tmdbotel.Middleware()},
+//	})
+//
+// Each Exec call produces a span named after the logical endpoint (e.g. "tmdb.movies.details")
+// and is counted in the tmdb.client.requests counter and tmdb.client.request.duration histogram.
+package tmdbotel
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/falconer001/gotmdb/client"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const scopeName = "github.com/falconer001/gotmdb/tmdbotel"
+
+// Attribute keys set on spans and metrics.
+const (
+	AttrEndpoint     = attribute.Key("tmdb.endpoint")
+	AttrPathTemplate = attribute.Key("tmdb.path_template")
+	AttrTMDBStatus   = attribute.Key("tmdb.status_code")
+	AttrCacheHit     = attribute.Key("tmdb.cache_hit")
+	AttrRetries      = attribute.Key("tmdb.retries")
+	AttrMethod       = attribute.Key("http.request.method")
+	AttrHTTPStatus   = attribute.Key("http.response.status_code")
+)
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+}
+
+// Option configures the middleware.
+type Option func(*config)
+
+// WithTracerProvider sets the tracer provider. Defaults to otel.GetTracerProvider().
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *config) { c.tracerProvider = tp }
+}
+
+// WithMeterProvider sets the meter provider. Defaults to otel.GetMeterProvider().
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(c *config) { c.meterProvider = mp }
+}
+
+// Middleware returns a client.Middleware recording a span, a request count and a latency
+// measurement for each call. Put it in Config.Middlewares so that it wraps the built-in
+// cache and retry middlewares and can report cache hits and retries.
+func Middleware(opts ...Option) client.Middleware {
+	cfg := config{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	tracer := cfg.tracerProvider.Tracer(scopeName)
+	meter := cfg.meterProvider.Meter(scopeName)
+
+	// Instrument creation only fails on invalid names; report it and skip the instrument
+	requests, err := meter.Int64Counter("tmdb.client.requests",
+		metric.WithDescription("Number of requests made to the TMDb API."),
+		metric.WithUnit("{request}"))
+	if err != nil {
+		otel.Handle(err)
+	}
+	duration, err := meter.Float64Histogram("tmdb.client.request.duration",
+		metric.WithDescription("Duration of requests made to the TMDb API, including retries."),
+		metric.WithUnit("s"))
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	return func(next client.Handler) client.Handler {
+		return func(ctx context.Context, req *client.Request) (*client.Response, error) {
+			template, name := client.Route(req.Path)
+			ctx, span := tracer.Start(ctx, name,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(
+					AttrEndpoint.String(name),
+					AttrPathTemplate.String(template),
+					AttrMethod.String(req.Method),
+				))
+			defer span.End()
+
+			start := time.Now()
+			resp, err := next(ctx, req)
+			elapsed := time.Since(start)
+
+			attrs := []attribute.KeyValue{
+				AttrEndpoint.String(name),
+				AttrMethod.String(req.Method),
+			}
+			if resp != nil {
+				attrs = append(attrs,
+					AttrHTTPStatus.Int(resp.StatusCode),
+					AttrCacheHit.Bool(resp.CacheHit))
+			}
+			if code, ok := tmdbStatusCode(err); ok {
+				span.SetAttributes(AttrTMDBStatus.Int(code))
+			}
+			span.SetAttributes(attrs...)
+			span.SetAttributes(AttrRetries.Int(max(req.Attempt-1, 0)))
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+
+			if requests != nil {
+				requests.Add(ctx, 1, metric.WithAttributes(attrs...))
+			}
+			if duration != nil {
+				duration.Record(ctx, elapsed.Seconds(), metric.WithAttributes(attrs...))
+			}
+			return resp, err
+		}
+	}
+}
+
+// tmdbStatusCode extracts TMDb's own status_code from an error response.
+func tmdbStatusCode(err error) (int, bool) {
+	var apiErr *client.TMDBError
+	if !errors.As(err, &apiErr) || apiErr.Code == 0 {
+		return 0, false
+	}
+	return apiErr.Code, true
+}
@@ -0,0 +1,133 @@
+package tmdbotel_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/falconer001/gotmdb"
+	"github.com/falconer001/gotmdb/client"
+	"github.com/falconer001/gotmdb/tmdbotel"
+	"github.com/falconer001/gotmdb/tmdbtest"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// attrs returns the attributes of a span as a map.
+func attrs(kvs []attribute.KeyValue) map[attribute.Key]attribute.Value {
+	m := make(map[attribute.Key]attribute.Value, len(kvs))
+	for _, kv := range kvs {
+		m[kv.Key] = kv.Value
+	}
+	return m
+}
+
+func TestMiddleware(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+
+	spans := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+	config := srv.Config()
+	config.Middlewares = []client.Middleware{tmdbotel.Middleware(
+		tmdbotel.WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))),
+		tmdbotel.WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+	)}
+	tmdb, err := gotmdb.New(config)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	ctx := context.Background()
+	if _, err := tmdb.Movies.GetDetails(550).ExecContext(ctx); err != nil {
+		t.Fatalf("GetDetails: %v", err)
+	}
+	srv.Fail("GET /movie/{id}/credits", http.StatusNotFound, 34, "The resource you requested could not be found.")
+	if _, err := tmdb.Movies.GetCredits(550).ExecContext(ctx); !errors.Is(err, client.ErrNotFound) {
+		t.Fatalf("err = %v, want ErrNotFound", err)
+	}
+
+	ended := spans.Ended()
+	if len(ended) != 2 {
+		t.Fatalf("recorded %d spans, want 2", len(ended))
+	}
+
+	ok := ended[0]
+	if ok.Name() != "tmdb.movies.details" || ok.SpanKind() != trace.SpanKindClient {
+		t.Errorf("span = %s (%s), want tmdb.movies.details (client)", ok.Name(), ok.SpanKind())
+	}
+	got := attrs(ok.Attributes())
+	want := map[attribute.Key]attribute.Value{
+		tmdbotel.AttrEndpoint:     attribute.StringValue("tmdb.movies.details"),
+		tmdbotel.AttrPathTemplate: attribute.StringValue("/movie/{id}"),
+		tmdbotel.AttrMethod:       attribute.StringValue("GET"),
+		tmdbotel.AttrHTTPStatus:   attribute.IntValue(200),
+		tmdbotel.AttrCacheHit:     attribute.BoolValue(false),
+		tmdbotel.AttrRetries:      attribute.IntValue(0),
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("%s = %v, want %v", k, got[k].Emit(), v.Emit())
+		}
+	}
+	if ok.Status().Code != codes.Unset {
+		t.Errorf("status = %v, want unset", ok.Status().Code)
+	}
+
+	failed := ended[1]
+	got = attrs(failed.Attributes())
+	if failed.Name() != "tmdb.movies.credits" || got[tmdbotel.AttrPathTemplate].AsString() != "/movie/{id}/credits" {
+		t.Errorf("span = %s %s, want tmdb.movies.credits /movie/{id}/credits", failed.Name(), got[tmdbotel.AttrPathTemplate].Emit())
+	}
+	if got[tmdbotel.AttrTMDBStatus].AsInt64() != 34 {
+		t.Errorf("%s = %v, want 34", tmdbotel.AttrTMDBStatus, got[tmdbotel.AttrTMDBStatus].Emit())
+	}
+	if failed.Status().Code != codes.Error || len(failed.Events()) == 0 {
+		t.Errorf("status = %v with %d events, want an error and its event", failed.Status().Code, len(failed.Events()))
+	}
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(ctx, &rm); err != nil {
+		t.Fatalf("Collect: %v", err)
+	}
+	var requests, durations uint64
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			switch data := m.Data.(type) {
+			case metricdata.Sum[int64]:
+				if m.Name != "tmdb.client.requests" {
+					continue
+				}
+				for _, dp := range data.DataPoints {
+					endpoint, _ := dp.Attributes.Value(tmdbotel.AttrEndpoint)
+					if endpoint.AsString() != "tmdb.movies.details" && endpoint.AsString() != "tmdb.movies.credits" {
+						t.Errorf("requests counted for %s", endpoint.Emit())
+					}
+					requests += uint64(dp.Value)
+				}
+			case metricdata.Histogram[float64]:
+				if m.Name != "tmdb.client.request.duration" || m.Unit != "s" {
+					continue
+				}
+				for _, dp := range data.DataPoints {
+					if dp.Sum <= 0 {
+						t.Errorf("duration sum = %f, want more than 0", dp.Sum)
+					}
+					durations += dp.Count
+				}
+			}
+		}
+	}
+	if requests != 2 {
+		t.Errorf("tmdb.client.requests = %d, want 2", requests)
+	}
+	if durations != 2 {
+		t.Errorf("tmdb.client.request.duration has %d measurements, want 2", durations)
+	}
+}
@@ -0,0 +1,138 @@
+package tmdbsync
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Checkpoint is the progress of the sync of a change list.
+type Checkpoint struct {
+	// From is the first day that isn't fully synced: the next sync starts there.
+	From time.Time `json:"from"`
+	// To, Page and Pages describe the window being synced, starting at From: its last day,
+	// the last page handled and its number of pages. To is zero when no window is in progress.
+	To    time.Time `json:"to,omitzero"`
+	Page  int       `json:"page,omitempty"`
+	Pages int       `json:"pages,omitempty"`
+}
+
+// Store persists checkpoints. Implementations must be safe for concurrent use.
+type Store interface {
+	// Load returns the checkpoint saved for key, and false if there is none.
+	Load(ctx context.Context, key string) (Checkpoint, bool, error)
+	// Save stores cp for key, replacing the previous checkpoint.
+	Save(ctx context.Context, key string, cp Checkpoint) error
+}
+
+// MemoryStore keeps checkpoints in memory, e.g. for tests or long-running processes.
+type MemoryStore struct {
+	mu          sync.Mutex
+	checkpoints map[string]Checkpoint
+}
+
+// NewMemoryStore creates an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{checkpoints: make(map[string]Checkpoint)}
+}
+
+// Load implements Store.
+func (s *MemoryStore) Load(_ context.Context, key string) (Checkpoint, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cp, ok := s.checkpoints[key]
+	return cp, ok, nil
+}
+
+// Save implements Store.
+func (s *MemoryStore) Save(_ context.Context, key string, cp Checkpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checkpoints[key] = cp
+	return nil
+}
+
+// FileStore keeps checkpoints in a JSON file, rewritten on every save.
+// The file is replaced atomically, so a crash never leaves it half written.
+type FileStore struct {
+	path string
+
+	mu sync.Mutex
+}
+
+// NewFileStore creates a FileStore using the file at path, which is created on the first save.
+func NewFileStore(path string) *FileStore {
+	return &FileStore{path: path}
+}
+
+// Load implements Store.
+func (s *FileStore) Load(_ context.Context, key string) (Checkpoint, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	checkpoints, err := s.read()
+	if err != nil {
+		return Checkpoint{}, false, err
+	}
+	cp, ok := checkpoints[key]
+	return cp, ok, nil
+}
+
+// Save implements Store.
+func (s *FileStore) Save(_ context.Context, key string, cp Checkpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	checkpoints, err := s.read()
+	if err != nil {
+		return err
+	}
+	if checkpoints == nil {
+		checkpoints = make(map[string]Checkpoint)
+	}
+	checkpoints[key] = cp
+
+	raw, err := json.MarshalIndent(checkpoints, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(raw); err != nil {
+		tmp.Close()
+		return err
+	}
+	// Flushed before the rename, or a power loss could leave an empty file in place of the checkpoints
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path)
+}
+
+func (s *FileStore) read() (map[string]Checkpoint, error) {
+	raw, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var checkpoints map[string]Checkpoint
+	if err := json.Unmarshal(raw, &checkpoints); err != nil {
+		return nil, fmt.Errorf("decoding %s: %w", s.path, err)
+	}
+	return checkpoints, nil
+}
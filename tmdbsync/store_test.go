@@ -0,0 +1,61 @@
+package tmdbsync_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/falconer001/gotmdb/tmdbsync"
+)
+
+func TestFileStore(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "state", "sync.json")
+
+	store := tmdbsync.NewFileStore(path)
+	if _, ok, err := store.Load(ctx, "movie"); err != nil || ok {
+		t.Fatalf("Load before any save: %t, %v, want nothing", ok, err)
+	}
+
+	movies := tmdbsync.Checkpoint{From: date("2024-01-01"), To: date("2024-01-14"), Page: 2, Pages: 3}
+	tv := tmdbsync.Checkpoint{From: date("2024-02-01")}
+	if err := store.Save(ctx, "movie", movies); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	if err := store.Save(ctx, "tv", tv); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	// A new store reads what the first one saved
+	store = tmdbsync.NewFileStore(path)
+	for key, want := range map[string]tmdbsync.Checkpoint{"movie": movies, "tv": tv} {
+		got, ok, err := store.Load(ctx, key)
+		if err != nil || !ok {
+			t.Fatalf("Load(%q): %t, %v", key, ok, err)
+		}
+		if !got.From.Equal(want.From) || !got.To.Equal(want.To) || got.Page != want.Page || got.Pages != want.Pages {
+			t.Errorf("Load(%q) = %+v, want %+v", key, got, want)
+		}
+	}
+
+	// No temporary file is left behind
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		t.Fatalf("ReadDir: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("the directory holds %d files, want 1", len(entries))
+	}
+}
+
+func TestFileStoreCorrupt(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sync.json")
+	if err := os.WriteFile(path, []byte("{"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := tmdbsync.NewFileStore(path).Load(context.Background(), "movie"); err == nil {
+		t.Error("Load of a corrupt file succeeded")
+	}
+}
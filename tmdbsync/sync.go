@@ -0,0 +1,174 @@
+// Package tmdbsync keeps a local copy of TMDb data up to date using the global change lists.
+//
+// A Syncer walks the change list of movies, TV shows or people over a period, calling a handler
+// for every changed ID, and saves its progress to a Store after each page so that an interrupted
+// sync resumes where it stopped:
+//
+//	s := &tmdbsync.Syncer{
+//		Changes: tmdb.Changes,
+//		Store:   tmdbsync.NewFileStore("sync.json"),
+//		Handle: func(ctx context.Context, kind tmdbsync.Kind, change types.ChangeItem) error {
+//			return refresh(ctx, kind, change.ID) // e.g. fetch the details again and store them
+//		},
+//	}
+//	err := s.Sync(ctx, tmdbsync.Movies, time.Now().AddDate(0, 0, -30), time.Now())
+//
+// Later syncs of the same kind start from the checkpoint, whatever start date they're given.
+// The last day of a sync is synced again by the next one since changes may have been added to it,
+// and an interrupted page is handled again on resume, so handlers should be idempotent.
+package tmdbsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/falconer001/gotmdb/endpoints"
+	"github.com/falconer001/gotmdb/options"
+	"github.com/falconer001/gotmdb/types"
+)
+
+// Kind selects a change list.
+type Kind string
+
+const (
+	Movies Kind = "movie"
+	TV     Kind = "tv"
+	People Kind = "person"
+)
+
+// MaxWindow is the longest period TMDb returns changes for in a single query.
+// Longer periods are synced window by window.
+const MaxWindow = 14 * 24 * time.Hour
+
+// maxPages is the last page TMDb serves.
+const maxPages = 500
+
+const dateLayout = "2006-01-02"
+
+// Handler is called for every changed ID. Returning an error stops the sync.
+type Handler func(ctx context.Context, kind Kind, change types.ChangeItem) error
+
+// Syncer syncs change lists. Its fields must be set before calling Sync.
+type Syncer struct {
+	// Changes is the endpoint of the change lists, e.g. tmdb.Changes.
+	Changes *endpoints.Changes
+	// Store persists the checkpoints, one per Kind.
+	Store Store
+	// Handle is called for every changed ID.
+	Handle Handler
+}
+
+// Sync calls Handle for every ID of the kind changed between the start and end days (inclusive).
+// If the store holds a checkpoint for the kind, the sync resumes from it and start is ignored.
+// Each ID is handled once per window of up to 14 days, in the order TMDb lists them.
+//
+// The checkpoint is saved after every page. If Handle, a request or saving the checkpoint fails,
+// or ctx is done, the sync stops and returns the error; the next Sync resumes at the page that failed.
+func (s *Syncer) Sync(ctx context.Context, kind Kind, start, end time.Time) error {
+	if s.Changes == nil || s.Store == nil || s.Handle == nil {
+		return errors.New("tmdb: Syncer needs Changes, Store and Handle")
+	}
+	if _, err := s.changeList(kind); err != nil {
+		return err
+	}
+
+	cp, ok, err := s.Store.Load(ctx, string(kind))
+	if err != nil {
+		return fmt.Errorf("tmdb: loading sync checkpoint: %w", err)
+	}
+	from, end := day(start), day(end)
+	if ok {
+		from = day(cp.From)
+	}
+
+	for !from.After(end) {
+		to := from.Add(MaxWindow - 24*time.Hour)
+		if to.After(end) {
+			to = end
+		}
+		page, done := 1, false
+		// Resumes the window in progress, keeping its bounds
+		if ok && !cp.To.IsZero() && day(cp.From).Equal(from) {
+			to, page = day(cp.To), cp.Page+1
+			// Its last page was handled but the sync stopped before moving on to the next window
+			done = (cp.Pages > 0 && cp.Page >= cp.Pages) || cp.Page >= maxPages
+		}
+		ok = false
+
+		if !done {
+			if err := s.syncWindow(ctx, kind, from, to, page); err != nil {
+				return err
+			}
+		}
+
+		if !to.Before(end) {
+			// The last day is synced again next time, as it may not be over yet
+			return s.save(ctx, kind, Checkpoint{From: to})
+		}
+		from = to.AddDate(0, 0, 1)
+		if err := s.save(ctx, kind, Checkpoint{From: from}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// syncWindow handles the changes from the from day to the to day, starting at page.
+func (s *Syncer) syncWindow(ctx context.Context, kind Kind, from, to time.Time, page int) error {
+	seen := make(map[int]bool)
+	for ; ; page++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		b, _ := s.changeList(kind)
+		resp, err := b.DateRange(from.Format(dateLayout), to.Format(dateLayout)).Page(page).NoCache().ExecContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, change := range resp.Results {
+			if seen[change.ID] {
+				continue
+			}
+			seen[change.ID] = true
+			if err := s.Handle(ctx, kind, change); err != nil {
+				return err
+			}
+		}
+		if err := s.save(ctx, kind, Checkpoint{From: from, To: to, Page: page, Pages: resp.TotalPages}); err != nil {
+			return err
+		}
+
+		if len(resp.Results) == 0 || page >= resp.TotalPages || page >= maxPages {
+			return nil
+		}
+	}
+}
+
+func (s *Syncer) save(ctx context.Context, kind Kind, cp Checkpoint) error {
+	if err := s.Store.Save(ctx, string(kind), cp); err != nil {
+		return fmt.Errorf("tmdb: saving sync checkpoint: %w", err)
+	}
+	return nil
+}
+
+// changeList returns a builder for the change list of kind.
+func (s *Syncer) changeList(kind Kind) (*options.ChangeListBuilder, error) {
+	switch kind {
+	case Movies:
+		return s.Changes.GetMovieChanges(), nil
+	case TV:
+		return s.Changes.GetTVChanges(), nil
+	case People:
+		return s.Changes.GetPersonChanges(), nil
+	}
+	return nil, fmt.Errorf("tmdb: unknown change list kind %q", kind)
+}
+
+// day returns the UTC day of t, at midnight.
+func day(t time.Time) time.Time {
+	y, m, d := t.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
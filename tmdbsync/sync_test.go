@@ -0,0 +1,283 @@
+package tmdbsync_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/falconer001/gotmdb"
+	"github.com/falconer001/gotmdb/client"
+	"github.com/falconer001/gotmdb/tmdbsync"
+	"github.com/falconer001/gotmdb/tmdbtest"
+	"github.com/falconer001/gotmdb/types"
+)
+
+// changeServer serves /movie/changes with 2 changes per page, failing the pages fail returns true for.
+type changeServer struct {
+	*tmdbtest.Server
+
+	mu    sync.Mutex
+	pages int
+	fail  func(page int) bool
+}
+
+func newChangeServer(t *testing.T, pages int) *changeServer {
+	s := &changeServer{Server: tmdbtest.NewServer(), pages: pages}
+	t.Cleanup(s.Close)
+	s.serve()
+	return s
+}
+
+// reset forgets the received requests.
+func (s *changeServer) reset() {
+	s.Reset()
+	s.serve()
+}
+
+func (s *changeServer) serve() {
+	s.Handle("GET /movie/changes", func(w http.ResponseWriter, r *http.Request) {
+		start, _ := time.Parse("2006-01-02", r.URL.Query().Get("start_date"))
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+
+		s.mu.Lock()
+		fail, pages := s.fail, s.pages
+		s.mu.Unlock()
+		if fail != nil && fail(page) {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"status_code": 11, "status_message": "Internal error: Something went wrong, contact TMDb."}`))
+			return
+		}
+		if page > pages {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"status_code": 22, "status_message": "Invalid page: Pages start at 1 and max at 500. They are expected to be an integer."}`))
+			return
+		}
+
+		// IDs are unique across windows and pages: 100005 is the 5th change of the window starting on day 100
+		results := []types.ChangeItem{}
+		for i := 1; i <= 2; i++ {
+			results = append(results, types.ChangeItem{ID: start.YearDay()*1000 + (page-1)*2 + i})
+		}
+		_ = json.NewEncoder(w).Encode(types.ChangeListResponse{
+			Paginated: types.Paginated{Page: page, TotalPages: pages, TotalResults: pages * 2},
+			Results:   results,
+		})
+	})
+}
+
+func (s *changeServer) failWhen(fail func(page int) bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fail = fail
+}
+
+// windows returns the start_date, end_date and page of the change list requests, in order.
+func (s *changeServer) windows() []string {
+	var windows []string
+	for _, r := range s.Requests() {
+		windows = append(windows, r.Query.Get("start_date")+" "+r.Query.Get("end_date")+" "+r.Query.Get("page"))
+	}
+	return windows
+}
+
+// recorder is a Handler recording the handled IDs.
+type recorder struct {
+	mu  sync.Mutex
+	ids []int
+}
+
+func (r *recorder) handle(_ context.Context, kind tmdbsync.Kind, change types.ChangeItem) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.ids = append(r.ids, change.ID)
+	return nil
+}
+
+func newSyncer(t *testing.T, srv *changeServer, store tmdbsync.Store, rec *recorder) *tmdbsync.Syncer {
+	t.Helper()
+	tmdb, err := gotmdb.New(srv.Config())
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	return &tmdbsync.Syncer{Changes: tmdb.Changes, Store: store, Handle: rec.handle}
+}
+
+func date(s string) time.Time {
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func loadCheckpoint(t *testing.T, store tmdbsync.Store) tmdbsync.Checkpoint {
+	t.Helper()
+	cp, ok, err := store.Load(context.Background(), string(tmdbsync.Movies))
+	if err != nil || !ok {
+		t.Fatalf("Load: %v, %t", err, ok)
+	}
+	return cp
+}
+
+func TestSyncSplitsWindows(t *testing.T) {
+	srv := newChangeServer(t, 1)
+	store := tmdbsync.NewMemoryStore()
+	rec := &recorder{}
+	s := newSyncer(t, srv, store, rec)
+
+	if err := s.Sync(context.Background(), tmdbsync.Movies, date("2024-01-01"), date("2024-02-10")); err != nil {
+		t.Fatalf("Sync: %v", err)
+	}
+
+	want := []string{
+		"2024-01-01 2024-01-14 1",
+		"2024-01-15 2024-01-28 1",
+		"2024-01-29 2024-02-10 1",
+	}
+	if got := srv.windows(); !slices.Equal(got, want) {
+		t.Errorf("requested %q, want %q", got, want)
+	}
+	if want := []int{1001, 1002, 15001, 15002, 29001, 29002}; !slices.Equal(rec.ids, want) {
+		t.Errorf("handled %v, want %v", rec.ids, want)
+	}
+	if cp := loadCheckpoint(t, store); !cp.From.Equal(date("2024-02-10")) || !cp.To.IsZero() {
+		t.Errorf("checkpoint = %+v, want From 2024-02-10 with no window in progress", cp)
+	}
+}
+
+func TestSyncResumesMidWindow(t *testing.T) {
+	srv := newChangeServer(t, 3)
+	store := tmdbsync.NewMemoryStore()
+	rec := &recorder{}
+	s := newSyncer(t, srv, store, rec)
+
+	srv.failWhen(func(page int) bool { return page == 2 })
+	err := s.Sync(context.Background(), tmdbsync.Movies, date("2024-01-01"), date("2024-01-20"))
+	if !errors.Is(err, client.ErrServiceUnavailable) {
+		t.Fatalf("err = %v, want ErrServiceUnavailable", err)
+	}
+	cp := loadCheckpoint(t, store)
+	if !cp.From.Equal(date("2024-01-01")) || !cp.To.Equal(date("2024-01-14")) || cp.Page != 1 || cp.Pages != 3 {
+		t.Errorf("checkpoint = %+v, want page 1 of 3 of 2024-01-01 to 2024-01-14", cp)
+	}
+
+	// The start date is ignored and the window resumes at the failed page
+	srv.reset()
+	srv.failWhen(nil)
+	rec.ids = nil
+	if err := s.Sync(context.Background(), tmdbsync.Movies, date("2023-06-01"), date("2024-01-20")); err != nil {
+		t.Fatalf("Sync: %v", err)
+	}
+	want := []string{
+		"2024-01-01 2024-01-14 2",
+		"2024-01-01 2024-01-14 3",
+		"2024-01-15 2024-01-20 1",
+		"2024-01-15 2024-01-20 2",
+		"2024-01-15 2024-01-20 3",
+	}
+	if got := srv.windows(); !slices.Equal(got, want) {
+		t.Errorf("requested %q, want %q", got, want)
+	}
+	if len(rec.ids) != 10 || rec.ids[0] != 1003 {
+		t.Errorf("handled %v, want 10 changes starting at 1003", rec.ids)
+	}
+}
+
+func TestSyncCompletedWindowNotSaved(t *testing.T) {
+	srv := newChangeServer(t, 3)
+	store := tmdbsync.NewMemoryStore()
+	rec := &recorder{}
+	s := newSyncer(t, srv, store, rec)
+
+	// The last page of the window was handled, but the sync stopped before saving the next window
+	ctx := context.Background()
+	_ = store.Save(ctx, string(tmdbsync.Movies), tmdbsync.Checkpoint{From: date("2024-01-01"), To: date("2024-01-14"), Page: 3, Pages: 3})
+	if err := s.Sync(ctx, tmdbsync.Movies, date("2024-01-01"), date("2024-01-15")); err != nil {
+		t.Fatalf("Sync: %v", err)
+	}
+	want := []string{
+		"2024-01-15 2024-01-15 1",
+		"2024-01-15 2024-01-15 2",
+		"2024-01-15 2024-01-15 3",
+	}
+	if got := srv.windows(); !slices.Equal(got, want) {
+		t.Errorf("requested %q, want %q", got, want)
+	}
+
+	// Checkpoints saved without the number of pages stop at TMDb's last page
+	srv.reset()
+	_ = store.Save(ctx, string(tmdbsync.Movies), tmdbsync.Checkpoint{From: date("2024-01-01"), To: date("2024-01-14"), Page: 500})
+	if err := s.Sync(ctx, tmdbsync.Movies, date("2024-01-01"), date("2024-01-15")); err != nil {
+		t.Fatalf("Sync: %v", err)
+	}
+	if got := srv.windows(); len(got) != 3 || got[0] != "2024-01-15 2024-01-15 1" {
+		t.Errorf("requested %q, want the 3 pages of 2024-01-15", got)
+	}
+}
+
+func TestSyncResyncsLastDay(t *testing.T) {
+	srv := newChangeServer(t, 1)
+	store := tmdbsync.NewMemoryStore()
+	rec := &recorder{}
+	s := newSyncer(t, srv, store, rec)
+	ctx := context.Background()
+
+	if err := s.Sync(ctx, tmdbsync.Movies, date("2024-01-01"), date("2024-01-10")); err != nil {
+		t.Fatalf("first Sync: %v", err)
+	}
+	if err := s.Sync(ctx, tmdbsync.Movies, date("2024-01-01"), date("2024-01-12")); err != nil {
+		t.Fatalf("second Sync: %v", err)
+	}
+	want := []string{
+		"2024-01-01 2024-01-10 1",
+		"2024-01-10 2024-01-12 1",
+	}
+	if got := srv.windows(); !slices.Equal(got, want) {
+		t.Errorf("requested %q, want %q", got, want)
+	}
+	if cp := loadCheckpoint(t, store); !cp.From.Equal(date("2024-01-12")) {
+		t.Errorf("checkpoint = %+v, want From 2024-01-12", cp)
+	}
+}
+
+func TestSyncHandlerError(t *testing.T) {
+	srv := newChangeServer(t, 2)
+	store := tmdbsync.NewMemoryStore()
+	errStop := errors.New("stop")
+	calls := 0
+	tmdb, err := gotmdb.New(srv.Config())
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	s := &tmdbsync.Syncer{
+		Changes: tmdb.Changes,
+		Store:   store,
+		Handle: func(ctx context.Context, kind tmdbsync.Kind, change types.ChangeItem) error {
+			calls++
+			if change.ID == 1003 {
+				return errStop
+			}
+			return nil
+		},
+	}
+
+	if err := s.Sync(context.Background(), tmdbsync.Movies, date("2024-01-01"), date("2024-01-05")); !errors.Is(err, errStop) {
+		t.Fatalf("err = %v, want the handler's error", err)
+	}
+	// Page 2 wasn't saved, so it's handled again next time
+	if cp := loadCheckpoint(t, store); cp.Page != 1 {
+		t.Errorf("checkpoint = %+v, want page 1", cp)
+	}
+	if err := s.Sync(context.Background(), tmdbsync.Movies, date("2024-01-01"), date("2024-01-05")); !errors.Is(err, errStop) {
+		t.Errorf("second Sync: err = %v, want the handler's error again", err)
+	}
+	if calls != 4 {
+		t.Errorf("handler called %d times, want 4", calls)
+	}
+}
@@ -0,0 +1,357 @@
+package tmdbtest
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+//go:embed fixtures/*.json
+var fixtureFS embed.FS
+
+const (
+	// pageSize is the number of results per page, as on TMDb.
+	pageSize = 20
+	// maxPages is the last page TMDb serves.
+	maxPages = 500
+)
+
+// resource builds the response for a sub-resource of a movie or TV show, e.g. its credits.
+// It's also used to answer append_to_response.
+type resource func(s *Server, r *http.Request, id int) map[string]any
+
+// movieResources are the sub-resources of /movie/{id}.
+var movieResources = map[string]resource{
+	"account_states":     fixtureWithID("account_states.json"),
+	"alternative_titles": fixtureWithID("alternative_titles.json"),
+	"changes":            fixtureWithID("changes.json"),
+	"credits":            fixtureWithID("credits.json"),
+	"external_ids":       fixtureWithID("external_ids.json"),
+	"images":             fixtureWithID("images.json"),
+	"keywords":           fixtureWithID("movie_keywords.json"),
+	"lists":              pagedWithID("list_result.json"),
+	"recommendations":    paged("movie_result.json"),
+	"release_dates":      fixtureWithID("release_dates.json"),
+	"reviews":            pagedWithID("review_result.json"),
+	"similar":            paged("movie_result.json"),
+	"translations":       fixtureWithID("translations.json"),
+	"videos":             fixtureWithID("videos.json"),
+	"watch/providers":    fixtureWithID("watch_providers.json"),
+}
+
+// tvResources are the sub-resources of /tv/{id}.
+var tvResources = map[string]resource{
+	"account_states":        fixtureWithID("account_states.json"),
+	"aggregate_credits":     fixtureWithID("aggregate_credits.json"),
+	"alternative_titles":    fixtureWithID("tv_alternative_titles.json"),
+	"changes":               fixtureWithID("changes.json"),
+	"content_ratings":       fixtureWithID("content_ratings.json"),
+	"credits":               fixtureWithID("credits.json"),
+	"episode_groups":        fixtureWithID("episode_groups.json"),
+	"external_ids":          fixtureWithID("tv_external_ids.json"),
+	"images":                fixtureWithID("images.json"),
+	"keywords":              fixtureWithID("tv_keywords.json"),
+	"recommendations":       paged("tv_result.json"),
+	"reviews":               pagedWithID("review_result.json"),
+	"screened_theatrically": fixtureWithID("screened_theatrically.json"),
+	"similar":               paged("tv_result.json"),
+	"translations":          fixtureWithID("tv_translations.json"),
+	"videos":                fixtureWithID("videos.json"),
+	"watch/providers":       fixtureWithID("watch_providers.json"),
+}
+
+// fixtureMux routes the requests not handled by a registered handler to the fixtures.
+func (s *Server) fixtureMux() *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, 34, "The resource you requested could not be found.")
+	})
+
+	// Movies
+	mux.HandleFunc("GET /3/movie/{id}", s.details("movie.json", movieResources))
+	mux.HandleFunc("GET /3/movie/latest", s.fixture("movie.json"))
+	mux.HandleFunc("GET /3/movie/popular", s.list("movie_result.json"))
+	mux.HandleFunc("GET /3/movie/top_rated", s.list("movie_result.json"))
+	mux.HandleFunc("GET /3/movie/now_playing", s.datedList("movie_result.json"))
+	mux.HandleFunc("GET /3/movie/upcoming", s.datedList("movie_result.json"))
+	s.subresources(mux, "/3/movie/{id}/", movieResources)
+	mux.HandleFunc("POST /3/movie/{id}/rating", s.rate)
+	mux.HandleFunc("DELETE /3/movie/{id}/rating", s.deleteRating)
+
+	// TV
+	mux.HandleFunc("GET /3/tv/{id}", s.details("tv.json", tvResources))
+	mux.HandleFunc("GET /3/tv/popular", s.list("tv_result.json"))
+	mux.HandleFunc("GET /3/tv/top_rated", s.list("tv_result.json"))
+	mux.HandleFunc("GET /3/tv/on_the_air", s.list("tv_result.json"))
+	mux.HandleFunc("GET /3/tv/airing_today", s.list("tv_result.json"))
+	s.subresources(mux, "/3/tv/{id}/", tvResources)
+	mux.HandleFunc("POST /3/tv/{id}/rating", s.rate)
+	mux.HandleFunc("DELETE /3/tv/{id}/rating", s.deleteRating)
+
+	// Search
+	mux.HandleFunc("GET /3/search/movie", s.search("movie_result.json"))
+	mux.HandleFunc("GET /3/search/tv", s.search("tv_result.json"))
+	mux.HandleFunc("GET /3/search/multi", s.search("multi_result.json"))
+	mux.HandleFunc("GET /3/search/company", s.search("company_result.json"))
+	mux.HandleFunc("GET /3/search/collection", s.search("collection_result.json"))
+	mux.HandleFunc("GET /3/search/keyword", s.search("keyword_result.json"))
+	mux.HandleFunc("GET /3/search/person", s.search("person_result.json"))
+
+	// Discover
+	mux.HandleFunc("GET /3/discover/movie", s.list("movie_result.json"))
+	mux.HandleFunc("GET /3/discover/tv", s.list("tv_result.json"))
+
+	return mux
+}
+
+// subresources registers the GET handlers of resources under prefix.
+func (s *Server) subresources(mux *http.ServeMux, prefix string, resources map[string]resource) {
+	for name, res := range resources {
+		h := s.subresource(res)
+		if name == "account_states" {
+			h = requireSession(h)
+		}
+		mux.HandleFunc("GET "+prefix+name, h)
+	}
+}
+
+// details serves the details of a movie or TV show, with the requested append_to_response sub-resources.
+func (s *Server) details(name string, resources map[string]resource) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, ok := pathID(w, r)
+		if !ok {
+			return
+		}
+		body := loadFixture(name)
+		body["id"] = id
+
+		appends := r.URL.Query().Get("append_to_response")
+		if appends != "" {
+			for _, name := range strings.Split(appends, ",") {
+				name = strings.TrimSpace(name)
+				if res, ok := resources[name]; ok {
+					body[name] = res(s, r, id)
+				}
+			}
+		}
+		writeJSON(w, http.StatusOK, body)
+	}
+}
+
+// subresource serves a sub-resource of the movie or TV show in the path.
+func (s *Server) subresource(res resource) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, ok := pathID(w, r)
+		if !ok {
+			return
+		}
+		if !validPage(w, r) {
+			return
+		}
+		writeJSON(w, http.StatusOK, res(s, r, id))
+	}
+}
+
+// fixture serves the fixture called name as is.
+func (s *Server) fixture(name string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, loadFixture(name))
+	}
+}
+
+// list serves a page of results built from the item fixture.
+func (s *Server) list(item string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !validPage(w, r) {
+			return
+		}
+		writeJSON(w, http.StatusOK, s.page(r, item))
+	}
+}
+
+// datedList is list with the dates object of /movie/now_playing and /movie/upcoming.
+func (s *Server) datedList(item string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !validPage(w, r) {
+			return
+		}
+		body := s.page(r, item)
+		body["dates"] = map[string]any{"maximum": "2024-06-26", "minimum": "2024-05-15"}
+		writeJSON(w, http.StatusOK, body)
+	}
+}
+
+// search is list returning nothing when the query is empty, as TMDb does.
+func (s *Server) search(item string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !validPage(w, r) {
+			return
+		}
+		if r.URL.Query().Get("query") == "" {
+			writeJSON(w, http.StatusOK, pageOf(item, 1, 0))
+			return
+		}
+		writeJSON(w, http.StatusOK, s.page(r, item))
+	}
+}
+
+// rate answers POST /{movie,tv}/{id}/rating.
+func (s *Server) rate(w http.ResponseWriter, r *http.Request) {
+	if _, ok := pathID(w, r); !ok {
+		return
+	}
+	if !hasSession(r) {
+		writeError(w, http.StatusUnauthorized, 3, "Authentication failed: You do not have permissions to access the service.")
+		return
+	}
+	var rating struct {
+		Value *float64 `json:"value"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&rating); err != nil || rating.Value == nil ||
+		*rating.Value < 0.5 || *rating.Value > 10 {
+		writeError(w, http.StatusBadRequest, 18, "Value too low: Value must be greater than 0.")
+		return
+	}
+	writeJSON(w, http.StatusCreated, map[string]any{
+		"success":        true,
+		"status_code":    1,
+		"status_message": "Success.",
+	})
+}
+
+// deleteRating answers DELETE /{movie,tv}/{id}/rating.
+func (s *Server) deleteRating(w http.ResponseWriter, r *http.Request) {
+	if _, ok := pathID(w, r); !ok {
+		return
+	}
+	if !hasSession(r) {
+		writeError(w, http.StatusUnauthorized, 3, "Authentication failed: You do not have permissions to access the service.")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"success":        true,
+		"status_code":    13,
+		"status_message": "The item/record was deleted successfully.",
+	})
+}
+
+// page builds the page of r's endpoint requested by r.
+func (s *Server) page(r *http.Request, item string) map[string]any {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	return pageOf(item, max(page, 1), s.totalResults(r))
+}
+
+// pageOf builds page out of total results. Item IDs run from 1 to total, so they're unique across pages.
+func pageOf(item string, page, total int) map[string]any {
+	totalPages := min((total+pageSize-1)/pageSize, maxPages)
+	results := []map[string]any{}
+	for n := (page-1)*pageSize + 1; n <= min(page*pageSize, total) && page <= totalPages; n++ {
+		results = append(results, numbered(loadFixture(item), n))
+	}
+	return map[string]any{
+		"page":          page,
+		"results":       results,
+		"total_pages":   totalPages,
+		"total_results": total,
+	}
+}
+
+// numbered gives item the ID n and numbers its title or name, e.g. "Fight Club 2".
+func numbered(item map[string]any, n int) map[string]any {
+	if _, ok := item["id"].(string); ok {
+		item["id"] = fmt.Sprintf("%024x", n)
+	} else {
+		item["id"] = n
+	}
+	for _, key := range []string{"title", "name"} {
+		if v, ok := item[key].(string); ok {
+			item[key] = fmt.Sprintf("%s %d", v, n)
+		}
+	}
+	return item
+}
+
+// fixtureWithID returns a resource serving the fixture called name with the ID of the movie or TV show.
+func fixtureWithID(name string) resource {
+	return func(s *Server, r *http.Request, id int) map[string]any {
+		body := loadFixture(name)
+		if _, ok := body["id"]; ok {
+			body["id"] = id
+		}
+		return body
+	}
+}
+
+// paged returns a resource serving pages of results built from the item fixture.
+func paged(item string) resource {
+	return func(s *Server, r *http.Request, id int) map[string]any {
+		return s.page(r, item)
+	}
+}
+
+// pagedWithID is paged with the ID of the movie or TV show, like /movie/{id}/reviews.
+func pagedWithID(item string) resource {
+	return func(s *Server, r *http.Request, id int) map[string]any {
+		body := s.page(r, item)
+		body["id"] = id
+		return body
+	}
+}
+
+// loadFixture decodes a fresh copy of the fixture called name.
+func loadFixture(name string) map[string]any {
+	raw, err := fixtureFS.ReadFile("fixtures/" + name)
+	if err != nil {
+		panic(fmt.Sprintf("tmdbtest: missing fixture %s", name))
+	}
+	var v map[string]any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		panic(fmt.Sprintf("tmdbtest: invalid fixture %s: %v", name, err))
+	}
+	return v
+}
+
+// pathID parses the {id} path wildcard, answering with TMDb's not found error if it isn't valid.
+func pathID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil || id <= 0 {
+		writeError(w, http.StatusNotFound, 34, "The resource you requested could not be found.")
+		return 0, false
+	}
+	return id, true
+}
+
+// validPage checks the page query parameter, answering with TMDb's invalid page error if it's out of range.
+func validPage(w http.ResponseWriter, r *http.Request) bool {
+	raw := r.URL.Query().Get("page")
+	if raw == "" {
+		return true
+	}
+	page, err := strconv.Atoi(raw)
+	if err != nil || page < 1 || page > maxPages {
+		writeError(w, http.StatusBadRequest, 22, "Invalid page: Pages start at 1 and max at 500. They are expected to be an integer.")
+		return false
+	}
+	return true
+}
+
+// hasSession reports whether r is made on behalf of a user: with a session, a guest session or a bearer token.
+func hasSession(r *http.Request) bool {
+	q := r.URL.Query()
+	return q.Get("session_id") != "" || q.Get("guest_session_id") != "" ||
+		r.Header.Get("Authorization") == "Bearer "+BearerToken
+}
+
+// requireSession rejects requests not made on behalf of a user.
+func requireSession(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !hasSession(r) {
+			writeError(w, http.StatusUnauthorized, 3, "Authentication failed: You do not have permissions to access the service.")
+			return
+		}
+		h(w, r)
+	}
+}
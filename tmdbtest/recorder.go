@@ -0,0 +1,294 @@
+package tmdbtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Mode selects what a Recorder does with requests.
+type Mode int
+
+const (
+	// ModeReplay answers requests from the cassette. Requests missing from it are sent to TMDb
+	// and added to the cassette, so a missing cassette file gets recorded on the first run.
+	ModeReplay Mode = iota
+	// ModeReplayStrict answers requests from the cassette only. Requests missing from it fail with ErrNoInteraction.
+	ModeReplayStrict
+	// ModeRecord sends every request to TMDb and records a new cassette, replacing the existing one.
+	ModeRecord
+)
+
+// ErrNoInteraction is returned in ModeReplayStrict for requests the cassette has no answer for.
+var ErrNoInteraction = errors.New("tmdbtest: no recorded interaction")
+
+// redacted replaces secrets in cassettes.
+const redacted = "REDACTED"
+
+// scrubbedParams are the query parameters holding secrets. They're redacted in cassettes and ignored when matching.
+var scrubbedParams = []string{"api_key", "session_id", "guest_session_id", "request_token"}
+
+// scrubbedFields matches the JSON fields holding secrets in request and response bodies.
+var scrubbedFields = regexp.MustCompile(`"(session_id|guest_session_id|request_token|access_token)"(\s*):(\s*)"[^"]*"`)
+
+// Recorder is an http.RoundTripper recording TMDb interactions to a cassette file and replaying them,
+// for deterministic integration tests. Use it as the transport of the client's HTTPClient:
+//
+//	rec, err := tmdbtest.NewRecorder("testdata/fight_club.json", tmdbtest.ModeReplay)
+//	if err != nil { ... }
+//	defer rec.Save()
+//
+//	tmdb, err := gotmdb.New(gotmdb.Config{
+//		APIKey:     os.Getenv("TMDB_API_KEY"),
+//		HTTPClient: &http.Client{Transport: rec},
+//	})
+//
+// The api_key, bearer tokens, session IDs and request tokens never reach the cassette.
+// Requests are matched by method, path and query, ignoring those secrets and the order of the parameters.
+// Identical requests are answered in the order they were recorded, the last answer being reused once they run out.
+type Recorder struct {
+	// Transport sends the requests that aren't replayed. Defaults to http.DefaultTransport.
+	Transport http.RoundTripper
+
+	path string
+	mode Mode
+
+	mu           sync.Mutex
+	interactions []*Interaction
+	used         map[*Interaction]bool
+	changed      bool
+}
+
+// Cassette is the content of a cassette file.
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a request as stored in a cassette.
+type RecordedRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+// RecordedResponse is a response as stored in a cassette.
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body"`
+}
+
+// NewRecorder creates a Recorder for the cassette at path.
+// The cassette is loaded unless mode is ModeRecord; it's an error for it to be missing in ModeReplayStrict.
+func NewRecorder(path string, mode Mode) (*Recorder, error) {
+	r := &Recorder{path: path, mode: mode, used: make(map[*Interaction]bool)}
+	if mode == ModeRecord {
+		return r, nil
+	}
+
+	raw, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) && mode == ModeReplay {
+		return r, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("tmdbtest: reading cassette: %w", err)
+	}
+	var cassette Cassette
+	if err := json.Unmarshal(raw, &cassette); err != nil {
+		return nil, fmt.Errorf("tmdbtest: decoding cassette %s: %w", path, err)
+	}
+	r.interactions = cassette.Interactions
+	return r, nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	if r.mode != ModeRecord {
+		if it := r.lookup(req); it != nil {
+			return it.Response.toHTTP(req), nil
+		}
+		if r.mode == ModeReplayStrict {
+			return nil, fmt.Errorf("%w for %s %s", ErrNoInteraction, req.Method, scrubURL(req.URL))
+		}
+	}
+
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	r.record(&Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			URL:    scrubURL(req.URL),
+			Body:   scrubBody(body),
+		},
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     recordedHeader(resp.Header),
+			Body:       scrubBody(respBody),
+		},
+	})
+	return resp, nil
+}
+
+// Save writes the cassette to its file if new interactions were recorded, creating the directory if needed.
+func (r *Recorder) Save() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.changed {
+		return nil
+	}
+
+	// HTML escaping would turn the &s of the URLs into \u0026
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(Cassette{Interactions: r.interactions}); err != nil {
+		return fmt.Errorf("tmdbtest: encoding cassette: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return fmt.Errorf("tmdbtest: creating cassette directory: %w", err)
+	}
+	if err := os.WriteFile(r.path, buf.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("tmdbtest: writing cassette: %w", err)
+	}
+	r.changed = false
+	return nil
+}
+
+// Interactions returns the interactions of the cassette, including the ones recorded so far.
+func (r *Recorder) Interactions() []*Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.interactions)
+}
+
+// lookup returns the interaction answering req, nil if there's none.
+func (r *Recorder) lookup(req *http.Request) *Interaction {
+	key := matchKey(req.Method, req.URL)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var last *Interaction
+	for _, it := range r.interactions {
+		u, err := url.Parse(it.Request.URL)
+		if err != nil || matchKey(it.Request.Method, u) != key {
+			continue
+		}
+		if !r.used[it] {
+			r.used[it] = true
+			return it
+		}
+		last = it
+	}
+	return last
+}
+
+func (r *Recorder) record(it *Interaction) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.interactions = append(r.interactions, it)
+	r.used[it] = true
+	r.changed = true
+}
+
+// toHTTP builds the *http.Response replaying rr.
+func (rr RecordedResponse) toHTTP(req *http.Request) *http.Response {
+	header := rr.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", rr.StatusCode, http.StatusText(rr.StatusCode)),
+		StatusCode:    rr.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(rr.Body)),
+		ContentLength: int64(len(rr.Body)),
+		Request:       req,
+	}
+}
+
+// matchKey identifies requests that are answered the same way: same method, path and query,
+// ignoring secrets and the order of the parameters.
+func matchKey(method string, u *url.URL) string {
+	query := u.Query()
+	for _, param := range scrubbedParams {
+		query.Del(param)
+	}
+	for _, values := range query {
+		slices.Sort(values)
+	}
+	// Encode sorts the parameters by key
+	return method + " " + u.Path + "?" + query.Encode()
+}
+
+// scrubURL returns u with the secrets in its query redacted.
+func scrubURL(u *url.URL) string {
+	scrubbed := *u
+	scrubbed.User = nil
+	query := u.Query()
+	for _, param := range scrubbedParams {
+		if query.Has(param) {
+			query.Set(param, redacted)
+		}
+	}
+	scrubbed.RawQuery = query.Encode()
+	return scrubbed.String()
+}
+
+// scrubBody redacts the session IDs and tokens in a JSON body.
+func scrubBody(body []byte) string {
+	return scrubbedFields.ReplaceAllString(string(body), `"$1"$2:$3"`+redacted+`"`)
+}
+
+// recordedHeader returns the response headers worth keeping in a cassette.
+func recordedHeader(h http.Header) http.Header {
+	out := h.Clone()
+	// The body may have been changed by scrubbing, and cookies have no place in a cassette
+	for _, key := range []string{"Content-Length", "Content-Encoding", "Set-Cookie"} {
+		out.Del(key)
+	}
+	return out
+}
@@ -0,0 +1,151 @@
+package tmdbtest_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/falconer001/gotmdb/client"
+	"github.com/falconer001/gotmdb/tmdbtest"
+)
+
+func TestRecorderRecordAndReplay(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	path := filepath.Join(t.TempDir(), "cassettes", "movie.json")
+
+	rec, err := tmdbtest.NewRecorder(path, tmdbtest.ModeRecord)
+	if err != nil {
+		t.Fatalf("NewRecorder: %v", err)
+	}
+	tmdb := newTMDB(t, srv, func(config *client.Config) { config.HTTPClient = &http.Client{Transport: rec} })
+	recorded, err := tmdb.Movies.GetDetails(550).Exec()
+	if err != nil {
+		t.Fatalf("recording: %v", err)
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading cassette: %v", err)
+	}
+	if strings.Contains(string(raw), tmdbtest.APIKey) {
+		t.Error("the cassette holds the API key")
+	}
+
+	// Replays with the server gone
+	srv.Close()
+	rec, err = tmdbtest.NewRecorder(path, tmdbtest.ModeReplayStrict)
+	if err != nil {
+		t.Fatalf("NewRecorder: %v", err)
+	}
+	tmdb = newTMDB(t, srv, func(config *client.Config) { config.HTTPClient = &http.Client{Transport: rec} })
+	replayed, err := tmdb.Movies.GetDetails(550).Exec()
+	if err != nil {
+		t.Fatalf("replaying: %v", err)
+	}
+	if replayed.ID != recorded.ID || replayed.Title != recorded.Title {
+		t.Errorf("replayed %d %q, want %d %q", replayed.ID, replayed.Title, recorded.ID, recorded.Title)
+	}
+
+	_, err = tmdb.Movies.GetDetails(551).Exec()
+	if !errors.Is(err, tmdbtest.ErrNoInteraction) {
+		t.Errorf("unrecorded request: err = %v, want ErrNoInteraction", err)
+	}
+}
+
+func TestRecorderScrubsSecrets(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	srv.Respond("POST /authentication/session/new", http.StatusOK, map[string]any{"success": true, "session_id": "secret-session"})
+	srv.Respond("POST /movie/{id}/rating", http.StatusCreated, map[string]any{"status_code": 1, "status_message": "Success."})
+	path := filepath.Join(t.TempDir(), "session.json")
+
+	rec, err := tmdbtest.NewRecorder(path, tmdbtest.ModeRecord)
+	if err != nil {
+		t.Fatalf("NewRecorder: %v", err)
+	}
+	config := srv.Config()
+	config.HTTPClient = &http.Client{Transport: rec}
+	c, err := client.New(config)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	ctx := context.Background()
+	body := map[string]string{"request_token": "secret-request-token", "session_id": "secret-session"}
+	if err := c.DoRequestContext(ctx, http.MethodPost, "/authentication/session/new", nil, body, nil); err != nil {
+		t.Fatalf("POST /authentication/session/new: %v", err)
+	}
+	rating := url.Values{"session_id": {"secret-session"}}
+	if err := c.DoRequestContext(ctx, http.MethodPost, "/movie/550/rating", rating, map[string]float64{"value": 8.5}, nil); err != nil {
+		t.Fatalf("POST /movie/550/rating: %v", err)
+	}
+	if err := c.DoRequestContext(ctx, http.MethodGet, "/search/movie", url.Values{"query": {"fight"}, "page": {"1"}}, nil, nil); err != nil {
+		t.Fatalf("GET /search/movie: %v", err)
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading cassette: %v", err)
+	}
+	for _, secret := range []string{tmdbtest.APIKey, "secret-session", "secret-request-token"} {
+		if strings.Contains(string(raw), secret) {
+			t.Errorf("the cassette holds %q", secret)
+		}
+	}
+	found := false
+	for _, it := range rec.Interactions() {
+		if !strings.Contains(it.Request.URL, "/3/authentication/session/new?") {
+			continue
+		}
+		found = true
+		if !strings.Contains(it.Request.Body, `"request_token":"REDACTED"`) || !strings.Contains(it.Request.Body, `"session_id":"REDACTED"`) {
+			t.Errorf("recorded request body %s, want the token and session REDACTED", it.Request.Body)
+		}
+		if !strings.Contains(it.Response.Body, `"session_id":"REDACTED"`) {
+			t.Errorf("recorded response body %s, want the session REDACTED", it.Response.Body)
+		}
+	}
+	if !found {
+		t.Error("the session request wasn't recorded")
+	}
+
+	// Replays with another API key and session, and the query in another order
+	srv.Close()
+	rec, err = tmdbtest.NewRecorder(path, tmdbtest.ModeReplayStrict)
+	if err != nil {
+		t.Fatalf("NewRecorder: %v", err)
+	}
+	req, err := http.NewRequest(http.MethodGet, srv.URL+"/3/search/movie?page=1&api_key=another-key&query=fight", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := rec.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("replaying the search: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("replayed status %d, want 200", resp.StatusCode)
+	}
+
+	config.APIKey = "another-key"
+	config.HTTPClient = &http.Client{Transport: rec}
+	if c, err = client.New(config); err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if err := c.DoRequestContext(ctx, http.MethodPost, "/movie/550/rating", url.Values{"session_id": {"another-session"}}, map[string]float64{"value": 8.5}, nil); err != nil {
+		t.Errorf("replaying the rating with another session: %v", err)
+	}
+}
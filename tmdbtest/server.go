@@ -0,0 +1,326 @@
+// Package tmdbtest provides a fake TMDb API server for testing code built on gotmdb without network access.
+//
+// The server answers the movie, TV, search and discover endpoints with canned fixtures,
+// generates paginated results for list endpoints and lets tests register their own responses,
+// errors, latencies and bursts of rate limiting:
+//
+//	srv := tmdbtest.NewServer()
+//	defer srv.Close()
+//
+//	srv.Fail("GET /movie/{id}", http.StatusNotFound, 34, "The resource you requested could not be found.")
+//
+//	tmdb, _ := gotmdb.New(srv.Config())
+//	_, err := tmdb.Movies.GetDetails(550).Exec() // errors.Is(err, client.ErrResourceNotFound)
+//
+// Patterns use the net/http.ServeMux syntax and are relative to the API root, e.g. "GET /movie/{id}/credits".
+package tmdbtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/falconer001/gotmdb/client"
+)
+
+const (
+	// APIKey is the API key accepted by the server.
+	APIKey = "tmdbtest-api-key"
+
+	// BearerToken is the Read Access Token accepted by the server.
+	BearerToken = "tmdbtest-bearer-token"
+
+	// apiPrefix is the path the API is served under, like the "/3" of https://api.themoviedb.org/3.
+	apiPrefix = "/3"
+
+	// defaultTotalResults is the number of results paginated endpoints return unless set with SetTotalResults.
+	defaultTotalResults = 100
+)
+
+// Request is a request received by the server.
+type Request struct {
+	Method string
+	// Path is relative to the API root, e.g. "/movie/550".
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+}
+
+// Server is a fake TMDb API server. It's safe for concurrent use.
+type Server struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	requests    []Request
+	handlers    patternSet[http.HandlerFunc]
+	latencies   patternSet[time.Duration]
+	totals      patternSet[int]
+	rateLimited int
+	retryAfter  time.Duration
+	fixtures    *http.ServeMux
+}
+
+// NewServer starts a fake TMDb server. Call Close when done with it.
+func NewServer() *Server {
+	s := &Server{}
+	s.fixtures = s.fixtureMux()
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Config returns a client configuration pointing at the server.
+// Add a BearerToken to it for endpoints needing one.
+func (s *Server) Config() client.Config {
+	return client.Config{
+		APIKey:  APIKey,
+		BaseURL: s.URL + apiPrefix,
+	}
+}
+
+// Handle makes requests matching pattern be served by h instead of the fixtures.
+// h sees the request path with the API prefix, and path wildcards are available through r.PathValue.
+// Registering the same pattern again replaces the previous handler.
+// As with ServeMux, wildcards match any segment: "GET /movie/{id}" also matches /movie/popular.
+func (s *Server) Handle(pattern string, h http.HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers.set(pattern, h)
+}
+
+// Respond makes requests matching pattern get status and body.
+// body is sent as is if it's a string or []byte, and encoded to JSON otherwise.
+func (s *Server) Respond(pattern string, status int, body any) {
+	raw, err := encodeBody(body)
+	if err != nil {
+		panic(fmt.Sprintf("tmdbtest: encoding response for %q: %v", pattern, err))
+	}
+	s.Handle(pattern, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json;charset=utf-8")
+		w.WriteHeader(status)
+		_, _ = w.Write(raw)
+	})
+}
+
+// Fail makes requests matching pattern fail with a TMDb error response,
+// e.g. Fail("GET /movie/{id}", 404, 34, "The resource you requested could not be found.").
+// See https://developer.themoviedb.org/docs/errors for TMDb's status codes.
+func (s *Server) Fail(pattern string, httpStatus, tmdbCode int, message string) {
+	s.Handle(pattern, func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, httpStatus, tmdbCode, message)
+	})
+}
+
+// SetLatency delays the responses to requests matching pattern by d.
+// A delay is cut short when the client gives up on the request.
+func (s *Server) SetLatency(pattern string, d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.latencies.set(pattern, d)
+}
+
+// SetTotalResults sets the number of results the paginated endpoints matching pattern report.
+// Results are served 20 per page and, like on TMDb, total_pages never goes past 500.
+// The default is 100 results, i.e. 5 pages.
+func (s *Server) SetTotalResults(pattern string, n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.totals.set(pattern, n)
+}
+
+// RateLimit makes the next n requests fail with 429 Too Many Requests.
+// If retryAfter is positive, the responses carry a Retry-After header (rounded up to the second).
+func (s *Server) RateLimit(n int, retryAfter time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rateLimited = n
+	s.retryAfter = retryAfter
+}
+
+// Requests returns the requests received so far, oldest first.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// Reset forgets the received requests and everything registered on the server.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = nil
+	s.handlers = patternSet[http.HandlerFunc]{}
+	s.latencies = patternSet[time.Duration]{}
+	s.totals = patternSet[int]{}
+	s.rateLimited = 0
+	s.retryAfter = 0
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := readBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, 5, "Invalid parameters: Your request parameters are incorrect.")
+		return
+	}
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{
+		Method: r.Method,
+		Path:   strings.TrimPrefix(r.URL.Path, apiPrefix),
+		Query:  r.URL.Query(),
+		Header: r.Header.Clone(),
+		Body:   body,
+	})
+	latency, _, _ := s.latencies.match(r)
+	handler, pattern, _ := s.handlers.match(r)
+	limited := s.rateLimited > 0
+	retryAfter := s.retryAfter
+	if limited {
+		s.rateLimited--
+	}
+	s.mu.Unlock()
+
+	if latency > 0 {
+		select {
+		case <-time.After(latency):
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	if limited {
+		if retryAfter > 0 {
+			w.Header().Set("Retry-After", fmt.Sprint(int(math.Ceil(retryAfter.Seconds()))))
+		}
+		writeError(w, http.StatusTooManyRequests, 25, "Your request count (41) is over the allowed limit of 40.")
+		return
+	}
+
+	if handler != nil {
+		// Serving through a ServeMux makes the path wildcards available to the handler
+		mux := http.NewServeMux()
+		mux.Handle(pattern, handler)
+		mux.ServeHTTP(w, r)
+		return
+	}
+
+	if !authorized(r) {
+		writeError(w, http.StatusUnauthorized, 7, "Invalid API key: You must be granted a valid key.")
+		return
+	}
+	s.fixtures.ServeHTTP(w, r)
+}
+
+// totalResults returns the number of results the paginated endpoint answering r reports.
+func (s *Server) totalResults(r *http.Request) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if n, _, ok := s.totals.match(r); ok {
+		return n
+	}
+	return defaultTotalResults
+}
+
+// authorized reports whether r carries the server's API key or bearer token.
+func authorized(r *http.Request) bool {
+	if r.URL.Query().Get("api_key") == APIKey {
+		return true
+	}
+	return r.Header.Get("Authorization") == "Bearer "+BearerToken
+}
+
+// patternSet maps ServeMux patterns to values.
+type patternSet[V any] struct {
+	values map[string]V
+	mux    *http.ServeMux
+}
+
+func (p *patternSet[V]) set(pattern string, v V) {
+	if p.values == nil {
+		p.values = make(map[string]V)
+	}
+	p.values[apiPattern(pattern)] = v
+
+	// ServeMux can't replace a pattern, so it's rebuilt from scratch
+	p.mux = http.NewServeMux()
+	for pat := range p.values {
+		p.mux.Handle(pat, http.NotFoundHandler())
+	}
+}
+
+// match returns the value of the most specific pattern matching r, along with the pattern.
+func (p *patternSet[V]) match(r *http.Request) (V, string, bool) {
+	var zero V
+	if p.mux == nil {
+		return zero, "", false
+	}
+	_, pattern := p.mux.Handler(r)
+	v, ok := p.values[pattern]
+	if !ok {
+		return zero, "", false
+	}
+	return v, pattern, true
+}
+
+// apiPattern prefixes the path of pattern with the API prefix,
+// e.g. "GET /movie/{id}" becomes "GET /3/movie/{id}".
+func apiPattern(pattern string) string {
+	method, path, found := strings.Cut(pattern, " ")
+	if !found {
+		return apiPrefix + pattern
+	}
+	return method + " " + apiPrefix + strings.TrimLeft(path, " ")
+}
+
+// writeError writes a TMDb error response.
+func writeError(w http.ResponseWriter, httpStatus, tmdbCode int, message string) {
+	writeJSON(w, httpStatus, map[string]any{
+		"success":        false,
+		"status_code":    tmdbCode,
+		"status_message": message,
+	})
+}
+
+// writeJSON writes v encoded to JSON.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	w.WriteHeader(status)
+	_, _ = w.Write(raw)
+}
+
+// readBody reads the body of r and puts it back for the handlers.
+func readBody(r *http.Request) ([]byte, error) {
+	if r.Body == nil {
+		return nil, nil
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+func encodeBody(body any) ([]byte, error) {
+	switch b := body.(type) {
+	case []byte:
+		return b, nil
+	case string:
+		return []byte(b), nil
+	default:
+		return json.Marshal(body)
+	}
+}
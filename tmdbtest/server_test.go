@@ -0,0 +1,193 @@
+package tmdbtest_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/falconer001/gotmdb"
+	"github.com/falconer001/gotmdb/client"
+	"github.com/falconer001/gotmdb/tmdbtest"
+)
+
+func newTMDB(t *testing.T, srv *tmdbtest.Server, configure func(*client.Config)) *gotmdb.TMDBClient {
+	t.Helper()
+	config := srv.Config()
+	if configure != nil {
+		configure(&config)
+	}
+	tmdb, err := gotmdb.New(config)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	return tmdb
+}
+
+func TestServerMovies(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	tmdb := newTMDB(t, srv, nil)
+
+	movie, err := tmdb.Movies.GetDetails(550).AppendToResponse("credits").Exec()
+	if err != nil {
+		t.Fatalf("GetDetails: %v", err)
+	}
+	if movie.ID != 550 || movie.Title == "" {
+		t.Errorf("got movie %d %q, want 550 with a title", movie.ID, movie.Title)
+	}
+	if movie.Credits == nil || len(movie.Credits.Cast) == 0 {
+		t.Error("appended credits are missing")
+	}
+
+	popular, err := tmdb.Movies.GetPopular().Page(2).Exec()
+	if err != nil {
+		t.Fatalf("GetPopular: %v", err)
+	}
+	if popular.Page != 2 || popular.TotalPages != 5 || len(popular.Results) != 20 {
+		t.Errorf("got page %d of %d with %d results, want page 2 of 5 with 20", popular.Page, popular.TotalPages, len(popular.Results))
+	}
+	if id := popular.Results[0].ID; id != 21 {
+		t.Errorf("first result of page 2 has ID %d, want 21", id)
+	}
+}
+
+func TestServerTV(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	tmdb := newTMDB(t, srv, nil)
+
+	show, err := tmdb.TV.GetDetails(1399).Exec()
+	if err != nil {
+		t.Fatalf("GetDetails: %v", err)
+	}
+	if show.ID != 1399 || show.Name == "" {
+		t.Errorf("got show %d %q, want 1399 with a name", show.ID, show.Name)
+	}
+
+	popular, err := tmdb.TV.GetPopular().Exec()
+	if err != nil {
+		t.Fatalf("GetPopular: %v", err)
+	}
+	if popular.TotalResults != 100 || len(popular.Results) != 20 {
+		t.Errorf("got %d results of %d, want 20 of 100", len(popular.Results), popular.TotalResults)
+	}
+}
+
+func TestServerSearch(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	tmdb := newTMDB(t, srv, nil)
+
+	srv.SetTotalResults("GET /search/movie", 3)
+	results, err := tmdb.Search.Movies("fight club").Exec()
+	if err != nil {
+		t.Fatalf("Search.Movies: %v", err)
+	}
+	if results.TotalResults != 3 || len(results.Results) != 3 {
+		t.Errorf("got %d results of %d, want 3 of 3", len(results.Results), results.TotalResults)
+	}
+
+	reqs := srv.Requests()
+	if q := reqs[len(reqs)-1].Query.Get("query"); q != "fight club" {
+		t.Errorf("query = %q, want %q", q, "fight club")
+	}
+
+	empty, err := tmdb.Search.TV("").Exec()
+	if err != nil {
+		t.Fatalf("Search.TV: %v", err)
+	}
+	if empty.TotalResults != 0 || len(empty.Results) != 0 {
+		t.Errorf("empty query got %d results, want none", empty.TotalResults)
+	}
+}
+
+func TestServerDiscover(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	tmdb := newTMDB(t, srv, nil)
+
+	movies, err := tmdb.Discover.DiscoverMovies().PrimaryReleaseYear(1999).Exec()
+	if err != nil {
+		t.Fatalf("DiscoverMovies: %v", err)
+	}
+	if len(movies.Results) != 20 {
+		t.Errorf("got %d movies, want 20", len(movies.Results))
+	}
+	reqs := srv.Requests()
+	if y := reqs[len(reqs)-1].Query.Get("primary_release_year"); y != "1999" {
+		t.Errorf("primary_release_year = %q, want 1999", y)
+	}
+
+	shows, err := tmdb.Discover.DiscoverTV().Exec()
+	if err != nil {
+		t.Fatalf("DiscoverTV: %v", err)
+	}
+	if len(shows.Results) != 20 {
+		t.Errorf("got %d shows, want 20", len(shows.Results))
+	}
+}
+
+func TestServerFail(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	tmdb := newTMDB(t, srv, nil)
+
+	srv.Fail("GET /movie/{id}", http.StatusNotFound, 34, "The resource you requested could not be found.")
+	_, err := tmdb.Movies.GetDetails(550).Exec()
+	if !errors.Is(err, client.ErrResourceNotFound) || !errors.Is(err, client.ErrNotFound) {
+		t.Fatalf("err = %v, want ErrResourceNotFound", err)
+	}
+
+	// Other endpoints are still served by the fixtures
+	if _, err := tmdb.TV.GetDetails(1399).Exec(); err != nil {
+		t.Errorf("TV.GetDetails: %v", err)
+	}
+}
+
+func TestServerRateLimitBurst(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	tmdb := newTMDB(t, srv, nil)
+
+	srv.RateLimit(2, 3*time.Second)
+	for i := range 2 {
+		_, err := tmdb.Movies.GetDetails(550).Exec()
+		var apiErr *client.TMDBError
+		if !errors.As(err, &apiErr) || !errors.Is(err, client.ErrRateLimited) {
+			t.Fatalf("request %d: err = %v, want ErrRateLimited", i+1, err)
+		}
+		if apiErr.RetryAfter != 3*time.Second || !apiErr.Retryable {
+			t.Errorf("request %d: RetryAfter = %s, Retryable = %t, want 3s and true", i+1, apiErr.RetryAfter, apiErr.Retryable)
+		}
+	}
+	if _, err := tmdb.Movies.GetDetails(550).Exec(); err != nil {
+		t.Errorf("request after the burst: %v", err)
+	}
+}
+
+func TestServerUnauthorized(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	tmdb := newTMDB(t, srv, func(config *client.Config) { config.APIKey = "wrong" })
+
+	_, err := tmdb.Movies.GetDetails(550).Exec()
+	if !errors.Is(err, client.ErrInvalidAPIKey) {
+		t.Fatalf("err = %v, want ErrInvalidAPIKey", err)
+	}
+}
+
+func TestServerLatency(t *testing.T) {
+	srv := tmdbtest.NewServer()
+	defer srv.Close()
+	tmdb := newTMDB(t, srv, nil)
+
+	srv.SetLatency("GET /movie/{id}", time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := tmdb.Movies.GetDetails(550).ExecContext(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want context.DeadlineExceeded", err)
+	}
+}
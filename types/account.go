@@ -48,6 +48,11 @@ type RatedMoviePaginatedResults struct {
 	Results   []RatedMovie `json:"results"`
 }
 
+// Items returns the results of the page.
+func (r *RatedMoviePaginatedResults) Items() []RatedMovie {
+	return r.Results
+}
+
 // RatedTVShow represents a TV show entry in the rated TV shows list.
 // It extends TVListResult with a 'rating' field.
 type RatedTVShow struct {
@@ -61,6 +66,11 @@ type RatedTVShowPaginatedResults struct {
 	Results   []RatedTVShow `json:"results"`
 }
 
+// Items returns the results of the page.
+func (r *RatedTVShowPaginatedResults) Items() []RatedTVShow {
+	return r.Results
+}
+
 // RatedTVEpisode represents a TV episode entry in the rated TV episodes list.
 // It extends TVEpisodeListResult (which needs definition) with a 'rating' field.
 type RatedTVEpisode struct {
@@ -74,3 +84,8 @@ type RatedTVEpisodePaginatedResults struct {
 	Results   []RatedTVEpisode `json:"results"`
 }
 
+// Items returns the results of the page.
+func (r *RatedTVEpisodePaginatedResults) Items() []RatedTVEpisode {
+	return r.Results
+}
+
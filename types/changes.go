@@ -13,6 +13,11 @@ type ChangeListResponse struct {
 	Results   []ChangeItem `json:"results"`
 }
 
+// Items returns the results of the page.
+func (r *ChangeListResponse) Items() []ChangeItem {
+	return r.Results
+}
+
 // ChangeItemDetail represents a specific change made to an item.
 // Used within ChangeGroup.
 type ChangeItemDetail struct {
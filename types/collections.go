@@ -45,3 +45,8 @@ type CollectionSearchResponse struct {
 	Results   []CollectionSearchResult `json:"results"`
 }
 
+// Items returns the results of the page.
+func (r *CollectionSearchResponse) Items() []CollectionSearchResult {
+	return r.Results
+}
+
@@ -175,6 +175,16 @@ type ReviewPaginatedResults struct {
 	TotalResults int      `json:"total_results"`
 }
 
+// Items returns the results of the page.
+func (r *ReviewPaginatedResults) Items() []Review {
+	return r.Results
+}
+
+// Pagination returns the pagination fields.
+func (r *ReviewPaginatedResults) Pagination() Paginated {
+	return Paginated{Page: r.Page, TotalPages: r.TotalPages, TotalResults: r.TotalResults}
+}
+
 // TranslationData holds the translated text fields.
 // Used within Translation structs.
 type TranslationData struct {
@@ -244,3 +254,8 @@ type Paginated struct {
 	TotalPages   int `json:"total_pages"`
 	TotalResults int `json:"total_results"`
 }
+
+// Pagination returns the pagination fields. It's promoted to every paginated response embedding Paginated.
+func (p Paginated) Pagination() Paginated {
+	return p
+}
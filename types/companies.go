@@ -56,3 +56,8 @@ type CompanySearchResponse struct {
 	Results   []CompanySearchResult `json:"results"`
 }
 
+// Items returns the results of the page.
+func (r *CompanySearchResponse) Items() []CompanySearchResult {
+	return r.Results
+}
+
@@ -11,3 +11,18 @@ type FindResponse struct {
 	TVSeasonResults  []TVSeasonListResult  `json:"tv_season_results"`
 }
 
+
+// ExternalSource is the database an external ID passed to find comes from.
+// See: https://developer.themoviedb.org/reference/find-by-id
+type ExternalSource string
+
+const (
+	ExternalSourceIMDb      ExternalSource = "imdb_id"      // Movies, TV shows, seasons, episodes and people, e.g. "tt0137523"
+	ExternalSourceTVDB      ExternalSource = "tvdb_id"      // TV shows, seasons and episodes
+	ExternalSourceWikidata  ExternalSource = "wikidata_id"  // e.g. "Q190050"
+	ExternalSourceFacebook  ExternalSource = "facebook_id"  // Movies, TV shows and people
+	ExternalSourceInstagram ExternalSource = "instagram_id" // Movies, TV shows and people
+	ExternalSourceTwitter   ExternalSource = "twitter_id"   // Movies, TV shows and people
+	ExternalSourceTikTok    ExternalSource = "tiktok_id"    // People
+	ExternalSourceYouTube   ExternalSource = "youtube_id"   // People
+)
@@ -7,6 +7,11 @@ type KeywordSearchResponse struct {
 	Results   []Keyword `json:"results"` // Uses the common Keyword struct
 }
 
+// Items returns the results of the page.
+func (r *KeywordSearchResponse) Items() []Keyword {
+	return r.Results
+}
+
 // KeywordMoviesResponse represents paginated movie results for a specific keyword.
 // Note: The API response includes the keyword ID at the top level.
 // See: https://developer.themoviedb.org/reference/keyword-movies
@@ -16,3 +21,8 @@ type KeywordMoviesResponse struct {
 	Results   []MovieListResult `json:"results"` // Uses MovieListResult (defined in movies.go)
 }
 
+// Items returns the results of the page.
+func (r *KeywordMoviesResponse) Items() []MovieListResult {
+	return r.Results
+}
+
@@ -1,13 +1,80 @@
 package types
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"fmt"
+)
 
-// ListItem represents an item within a list (often a movie or TV show).
-// The exact structure can vary, so using json.RawMessage might be necessary
-// if a generic approach is needed, or define specific types if list type is known.
-// For simplicity here, we assume it mirrors MovieListResult or TVListResult.
-// Consider using a more robust approach like an interface or RawMessage if needed.
-type ListItem json.RawMessage // Placeholder - Needs refinement based on actual usage
+// ListItem represents an item within a list: a movie or a TV show, told apart by MediaType.
+// Movie or TV is set, matching MediaType; neither is for a media type this package doesn't know.
+type ListItem struct {
+	MediaType string           // "movie" or "tv", or as sent by TMDb for other types
+	Movie     *MovieListResult // Set when MediaType is "movie"
+	TV        *TVListResult    // Set when MediaType is "tv"
+}
+
+// ID returns the ID of the movie or TV show.
+func (i ListItem) ID() int {
+	switch {
+	case i.Movie != nil:
+		return i.Movie.ID
+	case i.TV != nil:
+		return i.TV.ID
+	}
+	return 0
+}
+
+// UnmarshalJSON decodes the item into Movie or TV depending on its media_type.
+// Items without a media_type are movies, as lists used to only hold movies.
+// Items of other media types only get their MediaType, so that new types don't break decoding the whole list.
+func (i *ListItem) UnmarshalJSON(data []byte) error {
+	var head struct {
+		MediaType string `json:"media_type"`
+	}
+	if err := json.Unmarshal(data, &head); err != nil {
+		return err
+	}
+
+	switch head.MediaType {
+	case "movie", "":
+		var movie MovieListResult
+		if err := json.Unmarshal(data, &movie); err != nil {
+			return err
+		}
+		*i = ListItem{MediaType: "movie", Movie: &movie}
+	case "tv":
+		var tv TVListResult
+		if err := json.Unmarshal(data, &tv); err != nil {
+			return err
+		}
+		*i = ListItem{MediaType: "tv", TV: &tv}
+	default:
+		*i = ListItem{MediaType: head.MediaType}
+	}
+	return nil
+}
+
+// MarshalJSON encodes the item as TMDb does: the movie or TV show fields along with media_type.
+// Items of unknown media types only keep their media_type.
+func (i ListItem) MarshalJSON() ([]byte, error) {
+	switch {
+	case i.Movie != nil:
+		return json.Marshal(struct {
+			*MovieListResult
+			MediaType string `json:"media_type"`
+		}{i.Movie, "movie"})
+	case i.TV != nil:
+		return json.Marshal(struct {
+			*TVListResult
+			MediaType string `json:"media_type"`
+		}{i.TV, "tv"})
+	case i.MediaType != "":
+		return json.Marshal(struct {
+			MediaType string `json:"media_type"`
+		}{i.MediaType})
+	}
+	return nil, fmt.Errorf("tmdb: list item has neither a movie nor a TV show")
+}
 
 // ListDetails represents the details of a specific list.
 // See: https://developer.themoviedb.org/reference/list-details
@@ -16,11 +83,12 @@ type ListDetails struct {
 	Description   string     `json:"description"`
 	FavoriteCount int        `json:"favorite_count"`
 	ID            string     `json:"id"` // API docs say string, use string
-	Items         []ListItem `json:"items"` // Array of movies or TV shows (structure varies)
+	Items         []ListItem `json:"items"` // Movies and TV shows, see ListItem
 	ItemCount     int        `json:"item_count"`
 	ISO639_1      string     `json:"iso_639_1"` // Language code
 	Name          string     `json:"name"`
 	PosterPath    *string    `json:"poster_path"` // Nullable
+	Paginated                // Items are paginated, 20 per page
 }
 
 // ListItemStatusResponse indicates if a specific movie is in a list.
@@ -79,3 +147,8 @@ type ListPaginatedResults struct {
 	Results   []List `json:"results"`
 }
 
+// Items returns the results of the page.
+func (r *ListPaginatedResults) Items() []List {
+	return r.Results
+}
+
@@ -0,0 +1,54 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/falconer001/gotmdb/types"
+)
+
+func TestListItemUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name      string
+		json      string
+		mediaType string
+		id        int
+		movie, tv bool
+	}{
+		{"movie", `{"media_type":"movie","id":550,"title":"Fight Club"}`, "movie", 550, true, false},
+		{"tv", `{"media_type":"tv","id":1399,"name":"Game of Thrones"}`, "tv", 1399, false, true},
+		{"no media type", `{"id":550,"title":"Fight Club"}`, "movie", 550, true, false},
+		{"unknown media type", `{"media_type":"collection","id":10,"name":"Star Wars Collection"}`, "collection", 0, false, false},
+	}
+	for _, tt := range tests {
+		var item types.ListItem
+		if err := json.Unmarshal([]byte(tt.json), &item); err != nil {
+			t.Errorf("%s: Unmarshal: %v", tt.name, err)
+			continue
+		}
+		if item.MediaType != tt.mediaType || item.ID() != tt.id || (item.Movie != nil) != tt.movie || (item.TV != nil) != tt.tv {
+			t.Errorf("%s: got media type %q, ID %d, movie %t, TV %t, want %q, %d, %t, %t", tt.name,
+				item.MediaType, item.ID(), item.Movie != nil, item.TV != nil, tt.mediaType, tt.id, tt.movie, tt.tv)
+		}
+	}
+}
+
+func TestListItemUnknownMediaTypeInList(t *testing.T) {
+	// An item of an unknown type doesn't keep the rest of the list from decoding
+	data := `{"id":"8","items":[{"media_type":"movie","id":550},{"media_type":"collection","id":10},{"media_type":"tv","id":1399}]}`
+	var list types.ListDetails
+	if err := json.Unmarshal([]byte(data), &list); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if len(list.Items) != 3 || list.Items[0].ID() != 550 || list.Items[2].ID() != 1399 {
+		t.Fatalf("items = %+v, want the movie, the collection and the TV show", list.Items)
+	}
+
+	out, err := json.Marshal(list.Items[1])
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if string(out) != `{"media_type":"collection"}` {
+		t.Errorf("Marshal = %s, want the media type kept", out)
+	}
+}
@@ -81,6 +81,11 @@ type MoviePaginatedResults struct {
 	Results   []MovieListResult `json:"results"`
 }
 
+// Items returns the results of the page.
+func (r *MoviePaginatedResults) Items() []MovieListResult {
+	return r.Results
+}
+
 // ReleaseDateInfo represents a single release date entry for a country.
 type ReleaseDateInfo struct {
 	Certification string   `json:"certification"`
@@ -118,6 +123,11 @@ type NowPlayingResponse struct {
 	Results   []MovieListResult `json:"results"`
 }
 
+// Items returns the results of the page.
+func (r *NowPlayingResponse) Items() []MovieListResult {
+	return r.Results
+}
+
 // UpcomingResponse represents the response for the upcoming movies endpoint.
 // See: https://developer.themoviedb.org/reference/movie-upcoming-list
 type UpcomingResponse struct {
@@ -126,6 +136,11 @@ type UpcomingResponse struct {
 	Results   []MovieListResult `json:"results"`
 }
 
+// Items returns the results of the page.
+func (r *UpcomingResponse) Items() []MovieListResult {
+	return r.Results
+}
+
 // RatingRequest is the request body for adding/updating a movie or TV rating.
 type RatingRequest struct {
 	Value float64 `json:"value"` // Rating value (0.5 to 10.0 in 0.5 increments)
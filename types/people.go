@@ -206,6 +206,11 @@ type TaggedImagePaginatedResponse struct {
 	Results   []TaggedImage `json:"results"`
 }
 
+// Items returns the results of the page.
+func (r *TaggedImagePaginatedResponse) Items() []TaggedImage {
+	return r.Results
+}
+
 // PersonTranslationData holds the translatable biography field for a person.
 type PersonTranslationData struct {
 	Biography string `json:"biography"`
@@ -271,3 +276,8 @@ type PersonPaginatedResults struct {
 	Results   []PersonListResult `json:"results"`
 }
 
+// Items returns the results of the page.
+func (r *PersonPaginatedResults) Items() []PersonListResult {
+	return r.Results
+}
+
@@ -37,6 +37,11 @@ type SearchMultiResponse struct {
 	Results   []SearchMultiResult `json:"results"`
 }
 
+// Items returns the results of the page.
+func (r *SearchMultiResponse) Items() []SearchMultiResult {
+	return r.Results
+}
+
 // Note: Other search result types (CompanySearchResult, CollectionSearchResult,
 // KeywordSearchResponse, MoviePaginatedResults, PersonPaginatedResults,
 // TVShowPaginatedResults) are defined in their respective files (companies.go,
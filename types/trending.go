@@ -35,3 +35,26 @@ type TrendingResponse struct {
 	Results   []TrendingResult `json:"results"`
 }
 
+// Items returns the results of the page.
+func (r *TrendingResponse) Items() []TrendingResult {
+	return r.Results
+}
+
+
+// TrendingMediaType is the kind of items a trending list holds.
+type TrendingMediaType string
+
+const (
+	TrendingAll    TrendingMediaType = "all" // Movies, TV shows and people
+	TrendingMovie  TrendingMediaType = "movie"
+	TrendingTV     TrendingMediaType = "tv"
+	TrendingPerson TrendingMediaType = "person"
+)
+
+// TimeWindow is the period a trending list is computed over.
+type TimeWindow string
+
+const (
+	TimeWindowDay  TimeWindow = "day"
+	TimeWindowWeek TimeWindow = "week"
+)
@@ -137,6 +137,11 @@ type TVShowPaginatedResults struct {
 	Results   []TVListResult `json:"results"`
 }
 
+// Items returns the results of the page.
+func (r *TVShowPaginatedResults) Items() []TVListResult {
+	return r.Results
+}
+
 // Role represents a specific role played by an actor in AggregateCredits.
 type Role struct {
 	CreditID     string `json:"credit_id"`
@@ -205,13 +210,13 @@ type ContentRatingsResponse struct {
 
 // EpisodeGroup represents a group of episodes (e.g., a special collection).
 type EpisodeGroup struct {
-	Description  string   `json:"description"`
-	EpisodeCount int      `json:"episode_count"`
-	GroupCount   int      `json:"group_count"` // Number of subgroups/episodes within this group
-	ID           string   `json:"id"`          // Group ID (string)
-	Name         string   `json:"name"`
-	Network      *Network `json:"network,omitempty"` // Nullable, uses Network from networks.go
-	Type         int      `json:"type"`              // Type identifier (e.g., 1 for original air date)
+	Description  string           `json:"description"`
+	EpisodeCount int              `json:"episode_count"`
+	GroupCount   int              `json:"group_count"` // Number of subgroups/episodes within this group
+	ID           string           `json:"id"`          // Group ID (string)
+	Name         string           `json:"name"`
+	Network      *Network         `json:"network,omitempty"` // Nullable, uses Network from networks.go
+	Type         EpisodeGroupType `json:"type"`              // e.g., EpisodeGroupDVD
 }
 
 // EpisodeGroupsResponse holds the episode groups for a TV show.
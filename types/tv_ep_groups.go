@@ -1,22 +1,81 @@
 package types
 
+import (
+	"cmp"
+	"slices"
+)
+
+// EpisodeGroupType is the kind of ordering an episode group gives to a series' episodes.
+// See: https://developer.themoviedb.org/reference/tv-series-episode-groups
+type EpisodeGroupType int
+
+const (
+	EpisodeGroupOriginalAirDate EpisodeGroupType = 1
+	EpisodeGroupAbsolute        EpisodeGroupType = 2
+	EpisodeGroupDVD             EpisodeGroupType = 3
+	EpisodeGroupDigital         EpisodeGroupType = 4
+	EpisodeGroupStoryArc        EpisodeGroupType = 5
+	EpisodeGroupProduction      EpisodeGroupType = 6
+	EpisodeGroupTV              EpisodeGroupType = 7
+)
+
 // GroupedEpisode represents an episode within an episode group.
 // It extends TVEpisodeListResult with an 'order' field.
 type GroupedEpisode struct {
-	TVEpisodeListResult         // Embed basic episode info
-	Order               int `json:"order"` // Order of the episode within this group
+	TVEpisodeListResult     // Embed basic episode info
+	Order               int `json:"order"` // Order of the episode within its subgroup, starting at 0
+}
+
+// EpisodeSubgroup represents a subgroup of an episode group, e.g. a DVD volume or a story arc.
+type EpisodeSubgroup struct {
+	ID       string           `json:"id"`
+	Name     string           `json:"name"`
+	Order    int              `json:"order"` // Order of the subgroup within the group
+	Locked   bool             `json:"locked"`
+	Episodes []GroupedEpisode `json:"episodes"`
 }
 
 // EpisodeGroupDetails represents the detailed information for a TV episode group.
 // See: https://developer.themoviedb.org/reference/tv-episode-group-details
 type EpisodeGroupDetails struct {
-	Description  string           `json:"description"`
-	EpisodeCount int              `json:"episode_count"`
-	GroupCount   int              `json:"group_count"`
-	Groups       []GroupedEpisode `json:"groups"` // List of episodes in this specific group
-	ID           string           `json:"id"` // Group ID (string)
-	Name         string           `json:"name"`
-	Network      *Network         `json:"network,omitempty"` // Nullable, uses Network from networks.go
-	Type         int              `json:"type"` // Type identifier
+	Description  string            `json:"description"`
+	EpisodeCount int               `json:"episode_count"`
+	GroupCount   int               `json:"group_count"`
+	Groups       []EpisodeSubgroup `json:"groups"` // The subgroups, each holding its episodes
+	ID           string            `json:"id"`     // Group ID (string)
+	Name         string            `json:"name"`
+	Network      *Network          `json:"network,omitempty"` // Nullable, uses Network from networks.go
+	Type         EpisodeGroupType  `json:"type"`
 }
 
+// OrderedEpisode is an episode placed in the order of an episode group.
+// The episode keeps its original season and episode numbers.
+type OrderedEpisode struct {
+	Episode   TVEpisodeListResult
+	GroupName string // Name of the subgroup, e.g. "Volume 1" or an arc title
+	Group     int    // Position of the subgroup, starting at 1
+	Number    int    // Position within the subgroup, starting at 1
+	Absolute  int    // Position among all the episodes of the group, starting at 1
+}
+
+// OrderedEpisodes returns the episodes of the group in its order: subgroup by subgroup, following their order.
+func (d *EpisodeGroupDetails) OrderedEpisodes() []OrderedEpisode {
+	groups := slices.Clone(d.Groups)
+	slices.SortStableFunc(groups, func(a, b EpisodeSubgroup) int { return cmp.Compare(a.Order, b.Order) })
+
+	var out []OrderedEpisode
+	for g, group := range groups {
+		episodes := slices.Clone(group.Episodes)
+		slices.SortStableFunc(episodes, func(a, b GroupedEpisode) int { return cmp.Compare(a.Order, b.Order) })
+		for n, ep := range episodes {
+			out = append(out, OrderedEpisode{
+				Episode:   ep.TVEpisodeListResult,
+				GroupName: group.Name,
+				Group:     g + 1,
+				Number:    n + 1,
+				Absolute:  len(out) + 1,
+			})
+		}
+	}
+	return out
+}
@@ -0,0 +1,95 @@
+package types_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/falconer001/gotmdb/types"
+)
+
+// grouped returns an episode of an episode group, its season and episode numbers taken from code ("S01E02").
+func grouped(code string, order int) types.GroupedEpisode {
+	var ep types.GroupedEpisode
+	if _, err := fmt.Sscanf(code, "S%02dE%02d", &ep.SeasonNumber, &ep.EpisodeNumber); err != nil {
+		panic(err)
+	}
+	ep.Name = code
+	ep.Order = order
+	return ep
+}
+
+// placed formats an ordered episode as "<group>.<number> #<absolute> <group name> <episode>".
+func placed(ep types.OrderedEpisode) string {
+	return fmt.Sprintf("%d.%d #%d %s S%02dE%02d", ep.Group, ep.Number, ep.Absolute, ep.GroupName,
+		ep.Episode.SeasonNumber, ep.Episode.EpisodeNumber)
+}
+
+func TestOrderedEpisodes(t *testing.T) {
+	tests := []struct {
+		name   string
+		groups []types.EpisodeSubgroup
+		want   []string
+	}{
+		{
+			name: "no groups",
+		},
+		{
+			name: "in order",
+			groups: []types.EpisodeSubgroup{
+				{Name: "Arc 1", Order: 0, Episodes: []types.GroupedEpisode{grouped("S01E01", 0), grouped("S01E02", 1)}},
+				{Name: "Arc 2", Order: 1, Episodes: []types.GroupedEpisode{grouped("S01E03", 0)}},
+			},
+			want: []string{"1.1 #1 Arc 1 S01E01", "1.2 #2 Arc 1 S01E02", "2.1 #3 Arc 2 S01E03"},
+		},
+		{
+			name: "groups and episodes out of order",
+			groups: []types.EpisodeSubgroup{
+				{Name: "Volume 2", Order: 2, Episodes: []types.GroupedEpisode{grouped("S02E01", 1), grouped("S01E12", 0)}},
+				{Name: "Volume 1", Order: 1, Episodes: []types.GroupedEpisode{grouped("S01E03", 2), grouped("S01E01", 0), grouped("S01E02", 1)}},
+			},
+			want: []string{
+				"1.1 #1 Volume 1 S01E01", "1.2 #2 Volume 1 S01E02", "1.3 #3 Volume 1 S01E03",
+				"2.1 #4 Volume 2 S01E12", "2.2 #5 Volume 2 S02E01",
+			},
+		},
+		{
+			name: "episodes reordered across seasons keep their numbers",
+			groups: []types.EpisodeSubgroup{
+				{Name: "Absolute", Episodes: []types.GroupedEpisode{grouped("S00E01", 1), grouped("S01E01", 0), grouped("S01E02", 2)}},
+			},
+			want: []string{"1.1 #1 Absolute S01E01", "1.2 #2 Absolute S00E01", "1.3 #3 Absolute S01E02"},
+		},
+		{
+			name: "ties keep the response order",
+			groups: []types.EpisodeSubgroup{
+				{Name: "A", Episodes: []types.GroupedEpisode{grouped("S01E02", 0), grouped("S01E01", 0)}},
+				{Name: "B", Episodes: []types.GroupedEpisode{grouped("S01E03", 0)}},
+			},
+			want: []string{"1.1 #1 A S01E02", "1.2 #2 A S01E01", "2.1 #3 B S01E03"},
+		},
+		{
+			name: "empty subgroups are skipped but keep their position",
+			groups: []types.EpisodeSubgroup{
+				{Name: "Specials", Order: 0},
+				{Name: "Season 1", Order: 1, Episodes: []types.GroupedEpisode{grouped("S01E01", 0)}},
+			},
+			want: []string{"2.1 #1 Season 1 S01E01"},
+		},
+	}
+
+	for _, tt := range tests {
+		details := &types.EpisodeGroupDetails{Groups: tt.groups}
+		before := fmt.Sprint(tt.groups)
+		var got []string
+		for _, ep := range details.OrderedEpisodes() {
+			got = append(got, placed(ep))
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+		}
+		if fmt.Sprint(details.Groups) != before {
+			t.Errorf("%s: OrderedEpisodes reordered the groups of the details", tt.name)
+		}
+	}
+}
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
 // WatchProviderRegion represents a region where watch providers are available.
 // See: https://developer.themoviedb.org/reference/watch-providers-available-regions
 type WatchProviderRegion struct {
@@ -57,3 +63,101 @@ type WatchProviderResponse struct {
 	Results map[string]CountryWatchProviders `json:"results"`
 }
 
+// Availability describes how a movie or TV show can be watched in a region.
+// Every list is sorted by display priority, the most prominent provider first.
+type Availability struct {
+	Region string // e.g., "US"
+	Link   string // Link to the TMDB watch page for this region
+
+	// Streaming lists the providers the title can be streamed from at no extra cost:
+	// Flatrate, Free and Ads combined, each provider listed once.
+	Streaming []WatchProvider
+
+	Flatrate []WatchProvider // Included in a subscription
+	Free     []WatchProvider // Free to watch
+	Ads      []WatchProvider // Free with ads
+	Rent     []WatchProvider
+	Buy      []WatchProvider
+}
+
+// Streamable reports whether the title can be streamed (flatrate, free or with ads) in the region.
+func (a Availability) Streamable() bool {
+	return len(a.Streaming) > 0
+}
+
+// Availability returns how the title can be watched in region (an ISO 3166-1 code such as "US"),
+// and false if it has no providers there.
+func (r *WatchProviderResponse) Availability(region string) (Availability, bool) {
+	region = strings.ToUpper(region)
+	country, ok := r.Results[region]
+	if !ok {
+		return Availability{Region: region}, false
+	}
+
+	a := Availability{
+		Region:   region,
+		Link:     country.Link,
+		Flatrate: byDisplayPriority(country.Flatrate),
+		Free:     byDisplayPriority(country.Free),
+		Ads:      byDisplayPriority(country.Ads),
+		Rent:     byDisplayPriority(country.Rent),
+		Buy:      byDisplayPriority(country.Buy),
+	}
+	seen := make(map[int]bool)
+	for _, providers := range [][]WatchProvider{country.Flatrate, country.Free, country.Ads} {
+		for _, p := range providers {
+			if !seen[p.ProviderID] {
+				seen[p.ProviderID] = true
+				a.Streaming = append(a.Streaming, p)
+			}
+		}
+	}
+	a.Streaming = byDisplayPriority(a.Streaming)
+	return a, true
+}
+
+// StreamableIn reports whether the title can be streamed (flatrate, free or with ads) in region,
+// and returns the providers it can be streamed from, the most prominent first.
+func (r *WatchProviderResponse) StreamableIn(region string) (bool, []WatchProvider) {
+	a, _ := r.Availability(region)
+	return a.Streamable(), a.Streaming
+}
+
+// StreamingRegions returns the regions where the title can be streamed, sorted by code.
+func (r *WatchProviderResponse) StreamingRegions() []string {
+	var regions []string
+	for region, country := range r.Results {
+		if len(country.Flatrate)+len(country.Free)+len(country.Ads) > 0 {
+			regions = append(regions, region)
+		}
+	}
+	slices.Sort(regions)
+	return regions
+}
+
+// byDisplayPriority returns a copy of providers sorted by display priority, lowest first.
+func byDisplayPriority(providers []WatchProvider) []WatchProvider {
+	sorted := slices.Clone(providers)
+	slices.SortStableFunc(sorted, func(a, b WatchProvider) int {
+		return cmp.Compare(a.DisplayPriority, b.DisplayPriority)
+	})
+	return sorted
+}
+
+// Priority returns the display priority of a provider in region, falling back to its default priority
+// when it has none for the region. Lower values come first.
+func (p WatchProviderInfo) Priority(region string) int {
+	if priority, ok := p.DisplayPriorities[strings.ToUpper(region)]; ok {
+		return priority
+	}
+	return p.DisplayPriority
+}
+
+// ByPriority returns the providers sorted by their display priority in region, the most prominent first.
+func (r *WatchProviderListResponse) ByPriority(region string) []WatchProviderInfo {
+	sorted := slices.Clone(r.Results)
+	slices.SortStableFunc(sorted, func(a, b WatchProviderInfo) int {
+		return cmp.Compare(a.Priority(region), b.Priority(region))
+	})
+	return sorted
+}
@@ -0,0 +1,110 @@
+package types_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/falconer001/gotmdb/types"
+)
+
+func provider(id, priority int) types.WatchProvider {
+	return types.WatchProvider{ProviderID: id, DisplayPriority: priority}
+}
+
+// providerIDs returns the IDs of providers, in order.
+func providerIDs(providers []types.WatchProvider) []int {
+	ids := []int{}
+	for _, p := range providers {
+		ids = append(ids, p.ProviderID)
+	}
+	return ids
+}
+
+func TestAvailability(t *testing.T) {
+	resp := &types.WatchProviderResponse{Results: map[string]types.CountryWatchProviders{
+		"US": {
+			Link:     "https://www.themoviedb.org/movie/550/watch?locale=US",
+			Flatrate: []types.WatchProvider{provider(8, 3), provider(9, 1)},
+			Free:     []types.WatchProvider{provider(73, 10)},
+			Ads:      []types.WatchProvider{provider(8, 3), provider(300, 0)}, // Netflix is also in Flatrate
+			Rent:     []types.WatchProvider{provider(2, 5), provider(3, 4)},
+			Buy:      []types.WatchProvider{provider(2, 5)},
+		},
+		"FR": {
+			Rent: []types.WatchProvider{provider(2, 1)},
+		},
+	}}
+
+	tests := []struct {
+		region    string
+		found     bool
+		streaming []int
+		flatrate  []int
+		rent      []int
+	}{
+		{region: "US", found: true, streaming: []int{300, 9, 8, 73}, flatrate: []int{9, 8}, rent: []int{3, 2}},
+		{region: "us", found: true, streaming: []int{300, 9, 8, 73}, flatrate: []int{9, 8}, rent: []int{3, 2}},
+		{region: "FR", found: true, streaming: []int{}, flatrate: []int{}, rent: []int{2}},
+		{region: "DE", streaming: []int{}, flatrate: []int{}, rent: []int{}},
+	}
+	for _, tt := range tests {
+		a, found := resp.Availability(tt.region)
+		if found != tt.found {
+			t.Errorf("%s: found = %t, want %t", tt.region, found, tt.found)
+		}
+		if got := providerIDs(a.Streaming); !slices.Equal(got, tt.streaming) {
+			t.Errorf("%s: Streaming = %v, want %v", tt.region, got, tt.streaming)
+		}
+		if got := providerIDs(a.Flatrate); !slices.Equal(got, tt.flatrate) {
+			t.Errorf("%s: Flatrate = %v, want %v", tt.region, got, tt.flatrate)
+		}
+		if got := providerIDs(a.Rent); !slices.Equal(got, tt.rent) {
+			t.Errorf("%s: Rent = %v, want %v", tt.region, got, tt.rent)
+		}
+		if a.Region != strings.ToUpper(tt.region) {
+			t.Errorf("%s: Region = %q, want the upper case code", tt.region, a.Region)
+		}
+
+		streamable, providers := resp.StreamableIn(tt.region)
+		if streamable != (len(tt.streaming) > 0) || !slices.Equal(providerIDs(providers), tt.streaming) {
+			t.Errorf("%s: StreamableIn = %t, %v, want %v", tt.region, streamable, providerIDs(providers), tt.streaming)
+		}
+	}
+
+	// The response itself is left in TMDb's order
+	if got := providerIDs(resp.Results["US"].Flatrate); !slices.Equal(got, []int{8, 9}) {
+		t.Errorf("Availability sorted the response: Flatrate = %v", got)
+	}
+}
+
+func TestByPriority(t *testing.T) {
+	resp := &types.WatchProviderListResponse{Results: []types.WatchProviderInfo{
+		{ProviderID: 8, DisplayPriority: 1, DisplayPriorities: map[string]int{"US": 3, "FR": 0}},
+		{ProviderID: 9, DisplayPriority: 2, DisplayPriorities: map[string]int{"US": 1}},
+		{ProviderID: 337, DisplayPriority: 0},
+		{ProviderID: 350, DisplayPriority: 2},
+	}}
+
+	tests := []struct {
+		region string
+		want   []int
+	}{
+		{region: "US", want: []int{337, 9, 350, 8}},
+		{region: "us", want: []int{337, 9, 350, 8}},
+		{region: "FR", want: []int{8, 337, 9, 350}},
+		{region: "DE", want: []int{337, 8, 9, 350}}, // Default priorities, ties in the response's order
+	}
+	for _, tt := range tests {
+		var got []int
+		for _, p := range resp.ByPriority(tt.region) {
+			got = append(got, p.ProviderID)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.region, got, tt.want)
+		}
+	}
+	if resp.Results[0].ProviderID != 8 {
+		t.Error("ByPriority sorted the response")
+	}
+}